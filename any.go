@@ -0,0 +1,171 @@
+package gozod
+
+import (
+	"context"
+)
+
+// AnySchema accepts values of any type, for freeform fields such as arbitrary JSON blobs
+// Any requires a non-nil value unless Nilable or Optional is set; Unknown accepts nil too
+type AnySchema struct {
+	BaseSchema
+	unknown bool // Created by Unknown: nil passes and Type returns "unknown"
+}
+
+// Any creates a schema that accepts any non-nil value
+func Any() *AnySchema {
+	return &AnySchema{
+		BaseSchema: BaseSchema{required: true},
+	}
+}
+
+// Unknown creates a schema that accepts every value, including nil and missing keys
+func Unknown() *AnySchema {
+	return &AnySchema{
+		BaseSchema: BaseSchema{nilable: true},
+		unknown:    true,
+	}
+}
+
+// Nilable allows null values
+func (s *AnySchema) Nilable() *AnySchema {
+	s.nilable = true
+	return s
+}
+
+// Optional allows missing values without declaring null a valid value
+// Validation accepts nil as with Nilable; the difference shows in IsOptional and IsNilable
+func (s *AnySchema) Optional() *AnySchema {
+	s.required = false
+	return s
+}
+
+// Refine adds a custom validation function
+// The function receives the value and returns (isValid, errorMessage)
+// If isValid is false, validation fails with the provided errorMessage
+// If errorMessage is empty, a default message will be used
+// Options such as OnlyIfValid and AbortOnFailure control when it runs
+func (s *AnySchema) Refine(validator RefineFunc, opts ...RefineOption) *AnySchema {
+	s.BaseSchema.addRefinement(validator, opts...)
+	return s
+}
+
+// UseRefinement attaches a refinement registered with RegisterRefinement
+// It panics if no refinement is registered under name
+func (s *AnySchema) UseRefinement(name string) *AnySchema {
+	s.BaseSchema.useRefinement(name)
+	return s
+}
+
+// SuperRefine adds a super refinement validation function
+// Similar to Refine, but provides a context object for adding errors with custom paths and codes
+func (s *AnySchema) SuperRefine(validator SuperRefineFunc) *AnySchema {
+	s.BaseSchema.addSuperRefinement(validator)
+	return s
+}
+
+// AsyncRefine adds a context-aware validation function
+// The function receives the context passed to ValidateCtx and is awaited before validation returns
+func (s *AnySchema) AsyncRefine(validator AsyncRefineFunc) *AnySchema {
+	s.BaseSchema.addAsyncRefinement(validator)
+	return s
+}
+
+// RefineWithParent adds a validation function that also receives the enclosing object
+// It runs when the schema validates a field of a Map or Struct and the field itself is valid
+func (s *AnySchema) RefineWithParent(validator ParentRefineFunc) *AnySchema {
+	s.BaseSchema.addParentRefinement(validator)
+	return s
+}
+
+// Or returns a union that accepts values matching either s or other
+func (s *AnySchema) Or(other Schema) *UnionSchema {
+	return Union(s, other)
+}
+
+// And returns an intersection that requires values to match both s and other
+func (s *AnySchema) And(other Schema) *IntersectionSchema {
+	return Intersection(s, other)
+}
+
+// Validate validates a value against the any schema
+func (s *AnySchema) Validate(value any, path []any) *ValidationErrors {
+	return s.ValidateCtx(context.Background(), value, path)
+}
+
+// ValidateCtx validates a value using ctx for cancellation and request scoping
+func (s *AnySchema) ValidateCtx(ctx context.Context, value any, path []any) *ValidationErrors {
+	// Errors are collected in a local value and only escape to the heap when validation fails
+	var errors ValidationErrors
+
+	// Handle nil/nilable
+	// Only nilable allows explicit nil values
+	if value == nil {
+		if !s.allowsNil() {
+			msg := s.getErrorMessage(path, ErrCodeRequired, "Required")
+			errors.Add(path, ErrCodeRequired, msg)
+			return errors.orNil()
+		}
+		return nil
+	}
+
+	// Apply custom refinements (there is no type check)
+	s.applyRefinements(value, path, &errors)
+
+	// Apply async refinements
+	s.applyAsyncRefinements(ctx, value, path, &errors)
+
+	// Apply super refinements
+	s.applySuperRefinements(ctx, value, path, &errors)
+
+	return errors.orNil()
+}
+
+// Title sets a short human-readable title used in generated documentation
+func (s *AnySchema) Title(title string) *AnySchema {
+	s.annotations.Title = title
+	return s
+}
+
+// Describe sets a description used in generated documentation
+// It does not affect validation
+func (s *AnySchema) Describe(description string) *AnySchema {
+	s.annotations.Description = description
+	return s
+}
+
+// Example adds an example value used in generated documentation
+func (s *AnySchema) Example(example any) *AnySchema {
+	s.annotations.Examples = append(s.annotations.Examples, example)
+	return s
+}
+
+// Clone returns an independent copy of the schema
+// Refinements and custom errors are copied, so changing the clone does not affect s
+func (s *AnySchema) Clone() *AnySchema {
+	c := *s
+	c.BaseSchema = s.BaseSchema.clone()
+	return &c
+}
+
+// CustomError sets a custom error message for a specific error code
+func (s *AnySchema) CustomError(code, message string) *AnySchema {
+	if s.BaseSchema.customErrors == nil {
+		s.BaseSchema.customErrors = make(map[string]string)
+	}
+	s.BaseSchema.customErrors[code] = message
+	return s
+}
+
+// SetErrorFormatter sets a custom error formatter function
+func (s *AnySchema) SetErrorFormatter(formatter CustomErrorFunc) *AnySchema {
+	s.BaseSchema.errorFormatter = formatter
+	return s
+}
+
+// Type returns the schema type: "any", or "unknown" for schemas created by Unknown
+func (s *AnySchema) Type() string {
+	if s.unknown {
+		return "unknown"
+	}
+	return "any"
+}
@@ -0,0 +1,63 @@
+package gozod
+
+import (
+	"testing"
+)
+
+func TestAnySchema_Validate(t *testing.T) {
+	schema := Any()
+
+	for _, value := range []any{"text", 42, []any{1, "a"}, map[string]any{"k": nil}, false} {
+		if err := schema.Validate(value, nil); err != nil {
+			t.Errorf("Expected no errors for %v, got: %v", value, err)
+		}
+	}
+
+	err := schema.Validate(nil, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeRequired {
+		t.Errorf("Expected required error for nil, got: %v", err)
+	}
+	if err := Any().Nilable().Validate(nil, nil); err != nil {
+		t.Errorf("Expected nilable schema to accept nil, got: %v", err)
+	}
+
+	if schema.Type() != "any" {
+		t.Errorf("Expected type 'any', got %s", schema.Type())
+	}
+}
+
+func TestUnknownSchema_Validate(t *testing.T) {
+	schema := Map(map[string]Schema{
+		"name":    String(),
+		"payload": Unknown(),
+		"blob":    Any(),
+	})
+
+	// Missing and nil unknown fields pass; a missing Any field does not
+	err := schema.Validate(map[string]any{"name": "a", "blob": nil}, nil)
+	if err == nil || len(err.Errors) != 1 || PathToString(err.Errors[0].Path) != "blob" {
+		t.Errorf("Expected only the blob field to fail, got: %v", err)
+	}
+	if err := schema.Validate(map[string]any{"name": "a", "blob": []any{}, "payload": 3.5}, nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+
+	if Unknown().Type() != "unknown" {
+		t.Errorf("Expected type 'unknown', got %s", Unknown().Type())
+	}
+}
+
+func TestAnySchema_Refine(t *testing.T) {
+	schema := Any().Refine(func(value any) (bool, string) {
+		_, ok := value.(string)
+		return !ok, "Must not be a string"
+	})
+
+	if err := schema.Validate(1, nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+	err := schema.Validate("text", nil)
+	if err == nil || err.Errors[0].Message != "Must not be a string" {
+		t.Errorf("Expected refinement error, got: %v", err)
+	}
+}
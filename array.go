@@ -1,8 +1,12 @@
 package gozod
 
 import (
+	"context"
 	"fmt"
+	"math"
 	"reflect"
+	"slices"
+	"strings"
 )
 
 // ArraySchema validates array/slice values
@@ -12,6 +16,21 @@ type ArraySchema struct {
 	minLength     *int
 	maxLength     *int
 	nonEmpty      bool
+	sortedUnique  bool
+	unique        bool
+	uniqueBy      func(any) any
+	includes      []any
+	permutationOf []any
+	rejectNulls   bool
+	predicates    []arrayPredicate // Set by Some and Every, checked in the order added
+	maxItems      *int             // Hard ceiling checked before any element is touched, set by MaxItems
+}
+
+// arrayPredicate is a whole-array condition added by Some or Every
+type arrayPredicate struct {
+	fn      func(any) bool
+	message string
+	every   bool // Every requires all elements to match; Some requires at least one
 }
 
 // Array creates a new array schema
@@ -28,6 +47,13 @@ func (s *ArraySchema) Nilable() *ArraySchema {
 	return s
 }
 
+// Optional allows missing values without declaring null a valid value
+// Validation accepts nil as with Nilable; the difference shows in IsOptional and IsNilable
+func (s *ArraySchema) Optional() *ArraySchema {
+	s.required = false
+	return s
+}
+
 // Min sets the minimum length
 func (s *ArraySchema) Min(length int) *ArraySchema {
 	s.minLength = &length
@@ -40,18 +66,111 @@ func (s *ArraySchema) Max(length int) *ArraySchema {
 	return s
 }
 
+// Between sets the minimum and maximum length at once
+// It panics if min is greater than max
+func (s *ArraySchema) Between(min, max int) *ArraySchema {
+	if min > max {
+		panic(fmt.Sprintf("gozod: Between requires min <= max, got %v and %v", min, max))
+	}
+	s.minLength = &min
+	s.maxLength = &max
+	return s
+}
+
+// MaxItems sets a hard ceiling on the number of elements, to protect against oversized untrusted input
+// Unlike Max, it is checked before the elements are copied or validated, and exceeding it
+// fails with a single ErrCodeResourceLimit error and no other errors
+func (s *ArraySchema) MaxItems(n int) *ArraySchema {
+	s.maxItems = &n
+	return s
+}
+
+// Element returns the schema used to validate each element
+func (s *ArraySchema) Element() Schema {
+	return s.elementSchema
+}
+
 // NonEmpty validates that the array is not empty
 func (s *ArraySchema) NonEmpty() *ArraySchema {
 	s.nonEmpty = true
 	return s
 }
 
+// SortedUnique validates that elements are strictly increasing
+// Strict ordering implies uniqueness, so both properties are checked in a single pass
+// Elements must be numbers or strings
+func (s *ArraySchema) SortedUnique() *ArraySchema {
+	s.sortedUnique = true
+	return s
+}
+
+// Unique validates that all elements are distinct
+// Numbers compare by value across Go numeric types, other values by equality or reflect.DeepEqual
+func (s *ArraySchema) Unique() *ArraySchema {
+	s.unique = true
+	return s
+}
+
+// UniqueBy validates that the keys derived from each element are distinct
+// Use it for arrays of objects, e.g. to require unique ids
+func (s *ArraySchema) UniqueBy(key func(any) any) *ArraySchema {
+	s.unique = true
+	s.uniqueBy = key
+	return s
+}
+
+// Includes validates that at least one element equals the given value
+// Numbers compare by value across Go numeric types; may be called multiple times to require several values
+func (s *ArraySchema) Includes(value any) *ArraySchema {
+	s.includes = append(s.includes, value)
+	return s
+}
+
+// IsPermutationOf validates that the array contains exactly the given values, in any order
+// Each value must appear as many times as it does in values; numbers compare by value across Go numeric types
+func (s *ArraySchema) IsPermutationOf(values []any) *ArraySchema {
+	s.permutationOf = values
+	return s
+}
+
+// RejectNullElements fails on any nil element (including nil pointers, maps and slices),
+// even if the element schema is nilable
+// Use it where nulls inside a collection indicate corrupted upstream data
+func (s *ArraySchema) RejectNullElements() *ArraySchema {
+	s.rejectNulls = true
+	return s
+}
+
+// Some validates that at least one element satisfies pred
+// It runs after the elements passed validation; an empty array fails
+// message replaces the default when not empty
+func (s *ArraySchema) Some(pred func(any) bool, message string) *ArraySchema {
+	s.predicates = append(s.predicates, arrayPredicate{fn: pred, message: message})
+	return s
+}
+
+// Every validates that all elements satisfy pred, reporting one array-level error
+// whose Meta["indexes"] lists the elements that did not
+// It runs after the elements passed validation; an empty array passes
+func (s *ArraySchema) Every(pred func(any) bool, message string) *ArraySchema {
+	s.predicates = append(s.predicates, arrayPredicate{fn: pred, message: message, every: true})
+	return s
+}
+
 // Refine adds a custom validation function
 // The function receives the value and returns (isValid, errorMessage)
 // If isValid is false, validation fails with the provided errorMessage
 // If errorMessage is empty, a default message will be used
-func (s *ArraySchema) Refine(validator RefineFunc) *ArraySchema {
-	s.BaseSchema.addRefinement(validator)
+// Options such as OnlyIfValid and AbortOnFailure control when it runs
+func (s *ArraySchema) Refine(validator RefineFunc, opts ...RefineOption) *ArraySchema {
+	s.BaseSchema.addRefinement(validator, opts...)
+	return s
+}
+
+// UseRefinement attaches a refinement registered with RegisterRefinement
+// It panics if no refinement is registered under name
+func (s *ArraySchema) UseRefinement(name string) *ArraySchema {
+	s.BaseSchema.useRefinement(name)
 	return s
 }
 
@@ -63,72 +182,346 @@ func (s *ArraySchema) SuperRefine(validator SuperRefineFunc) *ArraySchema {
 	return s
 }
 
+// AsyncRefine adds a context-aware validation function
+// The function receives the context passed to ValidateCtx and is awaited before validation returns
+// Use it for expensive checks (e.g. database lookups) that need cancellation or deadlines
+func (s *ArraySchema) AsyncRefine(validator AsyncRefineFunc) *ArraySchema {
+	s.BaseSchema.addAsyncRefinement(validator)
+	return s
+}
+
+// RefineWithParent adds a validation function that also receives the enclosing object
+// It runs when the schema validates a field of a Map or Struct and the field itself is valid,
+// including a nil value on a Nilable field, which makes it suitable for conditionally required fields
+func (s *ArraySchema) RefineWithParent(validator ParentRefineFunc) *ArraySchema {
+	s.BaseSchema.addParentRefinement(validator)
+	return s
+}
+
+// Or returns a union that accepts values matching either s or other
+func (s *ArraySchema) Or(other Schema) *UnionSchema {
+	return Union(s, other)
+}
+
+// And returns an intersection that requires values to match both s and other
+func (s *ArraySchema) And(other Schema) *IntersectionSchema {
+	return Intersection(s, other)
+}
+
 // Validate validates a value against the array schema
 func (s *ArraySchema) Validate(value any, path []any) *ValidationErrors {
-	errors := &ValidationErrors{}
+	return s.ValidateCtx(context.Background(), value, path)
+}
+
+// ValidateCtx validates a value using ctx for cancellation and request scoping
+func (s *ArraySchema) ValidateCtx(ctx context.Context, value any, path []any) *ValidationErrors {
+	// Errors are collected in a local value and only escape to the heap when validation fails
+	var errors ValidationErrors
 
 	// Handle nil/nilable
 	// Only nilable allows explicit nil values
 	if value == nil {
-		if !s.nilable {
+		if !s.allowsNil() {
 			msg := s.getErrorMessage(path, ErrCodeRequired, "Required")
 			errors.Add(path, ErrCodeRequired, msg)
-			return errors
+			return errors.orNil()
 		}
 		return nil
 	}
 
 	// Convert to slice
-	var slice []any
-	val := reflect.ValueOf(value)
-
-	switch val.Kind() {
-	case reflect.Slice, reflect.Array:
+	// []any is used as is; other slices and arrays, of any element type, are copied element by element
+	// A non-nil pointer to a slice or array is dereferenced; MaxItems is checked before any copying
+	slice, ok := value.([]any)
+	if ok && s.exceedsMaxItems(len(slice), path, &errors) {
+		return errors.orNil()
+	}
+	if !ok {
+		val := reflect.ValueOf(value)
+		if val.Kind() == reflect.Pointer && !val.IsNil() {
+			val = val.Elem()
+		}
+		if val.Kind() != reflect.Slice && val.Kind() != reflect.Array {
+			msg := s.getErrorMessage(path, ErrCodeInvalidType, fmt.Sprintf("Expected array, got %T", value))
+			errors.Add(path, ErrCodeInvalidType, msg)
+			return errors.orNil()
+		}
+		if s.exceedsMaxItems(val.Len(), path, &errors) {
+			return errors.orNil()
+		}
 		slice = make([]any, val.Len())
 		for i := 0; i < val.Len(); i++ {
 			slice[i] = val.Index(i).Interface()
 		}
-	default:
-		msg := s.getErrorMessage(path, ErrCodeInvalidType, fmt.Sprintf("Expected array, got %T", value))
-		errors.Add(path, ErrCodeInvalidType, msg)
-		return errors
 	}
 
 	// Length validations
+	// Meta names the failed constraint so length errors can be told apart from element errors
 	if s.nonEmpty && len(slice) == 0 {
-		msg := s.getErrorMessage(path, ErrCodeTooSmall, "Array must not be empty")
-		errors.Add(path, ErrCodeTooSmall, msg)
+		meta := map[string]any{"constraint": "nonEmpty", "limit": 1, "minimum": 1, "actual": 0}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeTooSmall, "Array must not be empty", meta)
+		errors.AddWithMeta(path, ErrCodeTooSmall, msg, meta)
 	}
 
 	if s.minLength != nil && len(slice) < *s.minLength {
-		msg := s.getErrorMessage(path, ErrCodeTooSmall, fmt.Sprintf("Array must have at least %d element(s), got %d", *s.minLength, len(slice)))
-		errors.Add(path, ErrCodeTooSmall, msg)
+		meta := map[string]any{"constraint": "minLength", "limit": *s.minLength, "minimum": *s.minLength, "actual": len(slice)}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeTooSmall, fmt.Sprintf("Array must have at least %d element(s), got %d", *s.minLength, len(slice)), meta)
+		errors.AddWithMeta(path, ErrCodeTooSmall, msg, meta)
 	}
 
 	if s.maxLength != nil && len(slice) > *s.maxLength {
-		msg := s.getErrorMessage(path, ErrCodeTooBig, fmt.Sprintf("Array must have at most %d element(s), got %d", *s.maxLength, len(slice)))
-		errors.Add(path, ErrCodeTooBig, msg)
+		meta := map[string]any{"constraint": "maxLength", "limit": *s.maxLength, "maximum": *s.maxLength, "actual": len(slice)}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeTooBig, fmt.Sprintf("Array must have at most %d element(s), got %d", *s.maxLength, len(slice)), meta)
+		errors.AddWithMeta(path, ErrCodeTooBig, msg, meta)
+	}
+
+	// SortedUnique validation (reports the first violation only)
+	if s.sortedUnique {
+		s.validateSortedUnique(slice, path, &errors)
+	}
+
+	// Unique validation (reports the first duplicate only)
+	if s.unique {
+		s.validateUnique(slice, path, &errors)
+	}
+
+	// Includes validation
+	for _, required := range s.includes {
+		found := false
+		for _, element := range slice {
+			if valuesEqual(element, required) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			meta := map[string]any{"expected": required}
+			msg := s.getErrorMessageWithMeta(path, ErrCodeMissingElement, fmt.Sprintf("Array must include %v", required), meta)
+			errors.AddWithMeta(path, ErrCodeMissingElement, msg, meta)
+		}
+	}
+
+	// IsPermutationOf validation
+	if s.permutationOf != nil {
+		s.validatePermutation(slice, path, &errors)
+	}
+
+	// Record the top-level value for nested When schemas
+	ctx = withRoot(ctx, value)
+
+	// Stop at the nesting depth limit instead of descending into the contents
+	ctx, ok = s.enterNested(ctx, path, &errors)
+	if !ok {
+		return errors.orNil()
 	}
 
 	// Validate each element
+	elementsValid := true
 	for i, element := range slice {
 		elementPath := PathAppend(path, i)
-		elementErrors := s.elementSchema.Validate(element, elementPath)
+		if s.rejectNulls && isNilValue(element) {
+			msg := s.getErrorMessage(elementPath, ErrCodeInvalidType, "Array elements must not be null")
+			errors.Add(elementPath, ErrCodeInvalidType, msg)
+			elementsValid = false
+			continue
+		}
+		elementErrors := s.elementSchema.ValidateCtx(ctx, element, elementPath)
 		if elementErrors != nil {
+			elementsValid = elementsValid && !elementErrors.HasErrors()
 			errors.Errors = append(errors.Errors, elementErrors.Errors...)
 		}
 	}
 
+	// Some and Every validations (only if every element passed, so predicates see well-typed values)
+	if elementsValid {
+		for _, predicate := range s.predicates {
+			s.validatePredicate(predicate, slice, path, &errors)
+		}
+	}
+
 	// Apply custom refinements (only if type check passed)
-	s.applyRefinements(value, path, errors)
+	s.applyRefinements(value, path, &errors)
+
+	// Apply async refinements (only if type check passed)
+	s.applyAsyncRefinements(ctx, value, path, &errors)
 
 	// Apply super refinements (only if type check passed)
-	s.applySuperRefinements(value, path, errors)
+	s.applySuperRefinements(ctx, value, path, &errors)
 
-	if len(errors.Errors) == 0 {
-		return nil
+	return errors.orNil()
+}
+
+// exceedsMaxItems reports whether length is above the MaxItems ceiling, recording the error if so
+func (s *ArraySchema) exceedsMaxItems(length int, path []any, errors *ValidationErrors) bool {
+	if s.maxItems == nil || length <= *s.maxItems {
+		return false
 	}
-	return errors
+	meta := map[string]any{"constraint": "maxItems", "limit": *s.maxItems, "actual": length}
+	msg := s.getErrorMessageWithMeta(path, ErrCodeResourceLimit, fmt.Sprintf("Array has %d elements, more than the limit of %d", length, *s.maxItems), meta)
+	errors.AddWithMeta(path, ErrCodeResourceLimit, msg, meta)
+	return true
+}
+
+// validateSortedUnique checks that each element is strictly greater than the previous one
+func (s *ArraySchema) validateSortedUnique(slice []any, path []any, errors *ValidationErrors) {
+	for i := 1; i < len(slice); i++ {
+		cmp, ok := compareValues(slice[i-1], slice[i])
+		if !ok {
+			meta := map[string]any{"index": i}
+			msg := s.getErrorMessageWithMeta(path, ErrCodeInvalidType, fmt.Sprintf("Array elements at index %d and %d are not comparable", i-1, i), meta)
+			errors.AddWithMeta(path, ErrCodeInvalidType, msg, meta)
+			return
+		}
+		if cmp == 0 {
+			meta := map[string]any{"index": i}
+			msg := s.getErrorMessageWithMeta(path, ErrCodeNotUnique, fmt.Sprintf("Array elements must be unique, duplicate at index %d", i), meta)
+			errors.AddWithMeta(path, ErrCodeNotUnique, msg, meta)
+			return
+		}
+		if cmp > 0 {
+			meta := map[string]any{"index": i}
+			msg := s.getErrorMessageWithMeta(path, ErrCodeNotSorted, fmt.Sprintf("Array elements must be sorted in ascending order, out of order at index %d", i), meta)
+			errors.AddWithMeta(path, ErrCodeNotSorted, msg, meta)
+			return
+		}
+	}
+}
+
+// validateUnique checks that no element (or derived key) appears twice
+// Hashable values are tracked in a map; other values fall back to pairwise comparison
+func (s *ArraySchema) validateUnique(slice []any, path []any, errors *ValidationErrors) {
+	keys := make([]any, len(slice))
+	for i, element := range slice {
+		if s.uniqueBy != nil {
+			keys[i] = s.uniqueBy(element)
+		} else {
+			keys[i] = element
+		}
+	}
+
+	seen := make(map[any]int, len(keys))
+	for i, key := range keys {
+		first := -1
+		if hashKey, ok := hashableKey(key); ok {
+			if j, exists := seen[hashKey]; exists {
+				first = j
+			} else {
+				seen[hashKey] = i
+			}
+		} else {
+			for j := 0; j < i; j++ {
+				if valuesEqual(keys[j], key) {
+					first = j
+					break
+				}
+			}
+		}
+
+		if first >= 0 {
+			meta := map[string]any{"index": i, "duplicateOf": first}
+			msg := s.getErrorMessageWithMeta(path, ErrCodeNotUnique, fmt.Sprintf("Array elements must be unique, index %d duplicates index %d", i, first), meta)
+			errors.AddWithMeta(path, ErrCodeNotUnique, msg, meta)
+			return
+		}
+	}
+}
+
+// validatePermutation checks that slice and permutationOf hold the same multiset of values
+// Missing and unexpected values are reported in Meta
+func (s *ArraySchema) validatePermutation(slice []any, path []any, errors *ValidationErrors) {
+	remaining := make([]any, len(s.permutationOf))
+	copy(remaining, s.permutationOf)
+	extra := []any{}
+
+	for _, element := range slice {
+		matched := false
+		for j, candidate := range remaining {
+			if valuesEqual(element, candidate) {
+				remaining = append(remaining[:j], remaining[j+1:]...)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			extra = append(extra, element)
+		}
+	}
+
+	if len(remaining) == 0 && len(extra) == 0 {
+		return
+	}
+	meta := map[string]any{
+		"missing": remaining,
+		"extra":   extra,
+	}
+	msg := s.getErrorMessageWithMeta(path, ErrCodeNotPermutation, fmt.Sprintf("Array must contain exactly the values %v in any order", s.permutationOf), meta)
+	errors.AddWithMeta(path, ErrCodeNotPermutation, msg, meta)
+}
+
+// validatePredicate checks a Some or Every condition against the elements of slice
+func (s *ArraySchema) validatePredicate(predicate arrayPredicate, slice []any, path []any, errors *ValidationErrors) {
+	if !predicate.every {
+		if slices.ContainsFunc(slice, predicate.fn) {
+			return
+		}
+		meta := map[string]any{"constraint": "some"}
+		msg := s.predicateMessage(path, predicate.message, "At least one element must satisfy the condition", meta)
+		errors.AddWithMeta(path, ErrCodeCustomValidation, msg, meta)
+		return
+	}
+
+	var indexes []int
+	for i, element := range slice {
+		if !predicate.fn(element) {
+			indexes = append(indexes, i)
+		}
+	}
+	if len(indexes) == 0 {
+		return
+	}
+	meta := map[string]any{"constraint": "every", "indexes": indexes}
+	msg := s.predicateMessage(path, predicate.message, "All elements must satisfy the condition", meta)
+	errors.AddWithMeta(path, ErrCodeCustomValidation, msg, meta)
+}
+
+// predicateMessage returns the message for a failed Some or Every check
+// A message passed to Some or Every is kept over global defaults, as with Refine
+func (s *ArraySchema) predicateMessage(path []any, message, defaultMessage string, meta map[string]any) string {
+	if message == "" {
+		return s.getErrorMessageWithMeta(path, ErrCodeCustomValidation, defaultMessage, meta)
+	}
+	return s.formatErrorMessage(path, ErrCodeCustomValidation, message)
+}
+
+// Title sets a short human-readable title used in generated documentation
+func (s *ArraySchema) Title(title string) *ArraySchema {
+	s.annotations.Title = title
+	return s
+}
+
+// Describe sets a description used in generated documentation
+// It does not affect validation
+func (s *ArraySchema) Describe(description string) *ArraySchema {
+	s.annotations.Description = description
+	return s
+}
+
+// Example adds an example value used in generated documentation
+func (s *ArraySchema) Example(example any) *ArraySchema {
+	s.annotations.Examples = append(s.annotations.Examples, example)
+	return s
+}
+
+// Clone returns an independent copy of the schema
+// Constraints, refinements, custom errors and nested schemas are copied, so changing the clone does not affect s
+func (s *ArraySchema) Clone() *ArraySchema {
+	c := *s
+	c.BaseSchema = s.BaseSchema.clone()
+	c.elementSchema = CloneSchema(s.elementSchema)
+	c.includes = slices.Clone(s.includes)
+	c.permutationOf = slices.Clone(s.permutationOf)
+	c.predicates = slices.Clone(s.predicates)
+	return &c
 }
 
 // CustomError sets a custom error message for a specific error code
@@ -150,3 +543,160 @@ func (s *ArraySchema) SetErrorFormatter(formatter CustomErrorFunc) *ArraySchema
 func (s *ArraySchema) Type() string {
 	return "array"
 }
+
+// compareValues orders two scalar values
+// Integers and floats are compared numerically regardless of their Go type, strings lexically
+// The second return value is false when the values cannot be ordered against each other,
+// including when either is NaN, so NaN is never equal to anything
+func compareValues(a, b any) (int, bool) {
+	av := reflect.ValueOf(a)
+	bv := reflect.ValueOf(b)
+	if !av.IsValid() || !bv.IsValid() {
+		return 0, false
+	}
+
+	if av.Kind() == reflect.String && bv.Kind() == reflect.String {
+		return strings.Compare(av.String(), bv.String()), true
+	}
+
+	aKind, bKind := numericKind(av.Kind()), numericKind(bv.Kind())
+	if aKind == 0 || bKind == 0 {
+		return 0, false
+	}
+
+	switch {
+	case aKind == kindFloat || bKind == kindFloat:
+		af, bf := toFloat64(av), toFloat64(bv)
+		if math.IsNaN(af) || math.IsNaN(bf) {
+			return 0, false
+		}
+		return cmpOrdered(af, bf), true
+	case aKind == kindSigned && bKind == kindSigned:
+		return cmpOrdered(av.Int(), bv.Int()), true
+	case aKind == kindUnsigned && bKind == kindUnsigned:
+		return cmpOrdered(av.Uint(), bv.Uint()), true
+	case aKind == kindSigned:
+		// Signed vs unsigned: any negative number is smaller
+		if av.Int() < 0 {
+			return -1, true
+		}
+		return cmpOrdered(uint64(av.Int()), bv.Uint()), true
+	default:
+		if bv.Int() < 0 {
+			return 1, true
+		}
+		return cmpOrdered(av.Uint(), uint64(bv.Int())), true
+	}
+}
+
+// Numeric kind families used by compareValues
+const (
+	kindSigned = iota + 1
+	kindUnsigned
+	kindFloat
+)
+
+// numericKind maps a reflect.Kind to its numeric family, or 0 for non-numeric kinds
+func numericKind(k reflect.Kind) int {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return kindSigned
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return kindUnsigned
+	case reflect.Float32, reflect.Float64:
+		return kindFloat
+	default:
+		return 0
+	}
+}
+
+// toFloat64 converts a numeric reflect.Value to float64
+func toFloat64(v reflect.Value) float64 {
+	switch numericKind(v.Kind()) {
+	case kindSigned:
+		return float64(v.Int())
+	case kindUnsigned:
+		return float64(v.Uint())
+	default:
+		return v.Float()
+	}
+}
+
+// cmpOrdered returns -1, 0 or 1 depending on how a and b compare
+func cmpOrdered[T int64 | uint64 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// isNilValue reports whether v is nil or a nil pointer, map, slice or interface
+func isNilValue(v any) bool {
+	if v == nil {
+		return true
+	}
+	val := reflect.ValueOf(v)
+	switch val.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		return val.IsNil()
+	default:
+		return false
+	}
+}
+
+// valuesEqual reports whether two values are equal
+// Numbers and strings compare by value regardless of their Go type; anything else uses reflect.DeepEqual
+func valuesEqual(a, b any) bool {
+	if cmp, ok := compareValues(a, b); ok {
+		return cmp == 0
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// hashableKey normalizes a value into a map key consistent with valuesEqual
+// The second return value is false for values that cannot be used as map keys, and for numbers
+// beyond ±MaxSafeInteger: valuesEqual compares them with floats through a lossy float64
+// conversion, so they are left to the pairwise comparison
+func hashableKey(v any) (any, bool) {
+	if v == nil {
+		return nil, true
+	}
+	val := reflect.ValueOf(v)
+	switch numericKind(val.Kind()) {
+	case kindSigned:
+		if i := val.Int(); i >= -MaxSafeInteger && i <= MaxSafeInteger {
+			return i, true
+		}
+		return nil, false
+	case kindUnsigned:
+		if u := val.Uint(); u <= MaxSafeInteger {
+			return int64(u), true
+		}
+		return nil, false
+	case kindFloat:
+		f := val.Float()
+		if math.Abs(f) > MaxSafeInteger {
+			return nil, false
+		}
+		if f == math.Trunc(f) {
+			return int64(f), true
+		}
+		return f, true
+	}
+	if val.Kind() == reflect.String {
+		return val.String(), true
+	}
+	if !val.Type().Comparable() {
+		return nil, false
+	}
+	// Structs and arrays can be comparable as types yet hold non-comparable interface values
+	switch val.Kind() {
+	case reflect.Struct, reflect.Array, reflect.Interface:
+		return nil, false
+	}
+	return v, true
+}
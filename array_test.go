@@ -1,6 +1,7 @@
 package gozod
 
 import (
+	"math"
 	"testing"
 )
 
@@ -144,7 +145,25 @@ func TestArraySchema_NestedArrays(t *testing.T) {
 	// Invalid: wrong element type in nested array
 	err = schema.Validate([]any{[]int{1, 2}, []any{"invalid"}}, nil)
 	if err == nil {
-		t.Error("Expected error for invalid nested element")
+		t.Fatal("Expected error for invalid nested element")
+	}
+	if !PathEqual(err.Errors[0].Path, []any{1, 0}) {
+		t.Errorf("Expected error at [1][0], got %v", err.Errors[0].Path)
+	}
+	if got := PathToString(err.Errors[0].Path); got != "[1][0]" {
+		t.Errorf("Expected path string [1][0], got %q", got)
+	}
+
+	// Every failing element keeps its own path, including under a base path
+	err = schema.Validate([]any{[]any{"a", 1, "b"}, []any{2, "c"}}, []any{"matrix"})
+	if err == nil || len(err.Errors) != 3 {
+		t.Fatalf("Expected 3 errors, got: %v", err)
+	}
+	expected := [][]any{{"matrix", 0, 0}, {"matrix", 0, 2}, {"matrix", 1, 1}}
+	for i, path := range expected {
+		if !PathEqual(err.Errors[i].Path, path) {
+			t.Errorf("Expected error %d at %v, got %v", i, path, err.Errors[i].Path)
+		}
 	}
 }
 
@@ -163,6 +182,19 @@ func TestArraySchema_MixedTypes(t *testing.T) {
 	if err == nil {
 		t.Error("Expected error for invalid element type")
 	}
+
+	// Integers are only accepted when the element schema converts them
+	if err := schema.Validate([]int{1, 2, 3}, nil); err == nil {
+		t.Error("Expected errors for integer elements of Float()")
+	}
+	for _, elementSchema := range []*FloatSchema{Float().Coerce(), Float().AcceptInt()} {
+		if err := Array(elementSchema).Validate([]int{1, 2, 3}, nil); err != nil {
+			t.Errorf("Expected integer elements to be converted, got: %v", err)
+		}
+	}
+	if err := Array(Float().Coerce().Max(2)).Validate([]int{1, 2, 3}, nil); err == nil || PathToString(err.Errors[0].Path) != "[2]" {
+		t.Errorf("Expected the converted value to be checked, got: %v", err)
+	}
 }
 
 func TestArraySchema_InvalidType(t *testing.T) {
@@ -313,3 +345,534 @@ func TestArraySchema_ComplexElementSchema(t *testing.T) {
 		t.Error("Expected error for missing required field")
 	}
 }
+
+func TestArraySchema_SortedUnique(t *testing.T) {
+	schema := Array(Int()).SortedUnique()
+
+	// Valid: strictly increasing
+	err := schema.Validate([]int{1, 2, 5, 10}, nil)
+	if err != nil {
+		t.Errorf("Expected no errors for strictly increasing array, got: %v", err)
+	}
+
+	// Valid: empty and single-element arrays
+	if err := schema.Validate([]int{}, nil); err != nil {
+		t.Errorf("Expected no errors for empty array, got: %v", err)
+	}
+	if err := schema.Validate([]int{7}, nil); err != nil {
+		t.Errorf("Expected no errors for single-element array, got: %v", err)
+	}
+
+	// Invalid: equal adjacent elements
+	err = schema.Validate([]int{1, 2, 2, 3}, nil)
+	if err == nil {
+		t.Fatal("Expected error for duplicate elements")
+	}
+	if len(err.Errors) != 1 {
+		t.Fatalf("Expected exactly 1 error, got %d", len(err.Errors))
+	}
+	if err.Errors[0].Code != ErrCodeNotUnique {
+		t.Errorf("Expected error code %s, got %s", ErrCodeNotUnique, err.Errors[0].Code)
+	}
+	if err.Errors[0].Meta["index"] != 2 {
+		t.Errorf("Expected violation index 2, got %v", err.Errors[0].Meta["index"])
+	}
+
+	// Invalid: out of order (only the first violation is reported)
+	err = schema.Validate([]int{1, 3, 2, 0}, nil)
+	if err == nil {
+		t.Fatal("Expected error for unsorted elements")
+	}
+	if len(err.Errors) != 1 {
+		t.Fatalf("Expected exactly 1 error, got %d", len(err.Errors))
+	}
+	if err.Errors[0].Code != ErrCodeNotSorted {
+		t.Errorf("Expected error code %s, got %s", ErrCodeNotSorted, err.Errors[0].Code)
+	}
+	if err.Errors[0].Meta["index"] != 2 {
+		t.Errorf("Expected violation index 2, got %v", err.Errors[0].Meta["index"])
+	}
+}
+
+func TestArraySchema_SortedUnique_NaN(t *testing.T) {
+	schema := Array(Float()).SortedUnique()
+
+	// NaN is unordered, so it is neither a duplicate nor in order
+	for _, value := range [][]float64{{1, math.NaN()}, {math.NaN(), math.NaN()}} {
+		err := schema.Validate(value, nil)
+		if err == nil || len(err.Errors) != 1 || err.Errors[0].Code != ErrCodeInvalidType {
+			t.Errorf("Expected a single %s error for %v, got: %v", ErrCodeInvalidType, value, err)
+		}
+	}
+}
+
+func TestArraySchema_SortedUnique_Strings(t *testing.T) {
+	schema := Array(String()).SortedUnique()
+
+	err := schema.Validate([]string{"a", "b", "c"}, nil)
+	if err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+
+	err = schema.Validate([]string{"b", "a"}, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeNotSorted {
+		t.Errorf("Expected %s error, got: %v", ErrCodeNotSorted, err)
+	}
+}
+
+func TestArraySchema_Unique(t *testing.T) {
+	schema := Array(Int()).Unique()
+
+	// Valid: distinct elements in any order
+	err := schema.Validate([]int{3, 1, 2}, nil)
+	if err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+
+	// Invalid: duplicate element
+	err = schema.Validate([]int{1, 2, 3, 2}, nil)
+	if err == nil {
+		t.Fatal("Expected error for duplicate elements")
+	}
+	if err.Errors[0].Code != ErrCodeNotUnique {
+		t.Errorf("Expected error code %s, got %s", ErrCodeNotUnique, err.Errors[0].Code)
+	}
+	if err.Errors[0].Meta["index"] != 3 || err.Errors[0].Meta["duplicateOf"] != 1 {
+		t.Errorf("Expected duplicate at index 3 of index 1, got %v", err.Errors[0].Meta)
+	}
+
+	// Invalid: mixed integer types with equal values
+	err = schema.Validate([]any{1, int64(1)}, nil)
+	if err == nil {
+		t.Error("Expected error for equal values of different integer types")
+	}
+}
+
+func TestArraySchema_Unique_LargeNumbers(t *testing.T) {
+	schema := Array(Any()).Unique()
+
+	// Unique agrees with Includes and IsPermutationOf, which compare integers and floats as float64
+	for _, value := range [][]any{
+		{uint64(1 << 63), float64(1 << 63)},
+		{int64(1<<53 + 1), float64(1 << 53)},
+		{float64(1 << 53), int64(1 << 53)},
+	} {
+		err := schema.Validate(value, nil)
+		if err == nil || err.Errors[0].Code != ErrCodeNotUnique {
+			t.Errorf("Expected %s error for %v, got: %v", ErrCodeNotUnique, value, err)
+		}
+	}
+
+	if err := schema.Validate([]any{int64(1 << 53), int64(1<<53 + 1), uint64(1 << 63)}, nil); err != nil {
+		t.Errorf("Expected distinct integers to pass, got: %v", err)
+	}
+}
+
+func TestArraySchema_Unique_NonHashable(t *testing.T) {
+	schema := Array(Array(Int())).Unique()
+
+	err := schema.Validate([][]int{{1, 2}, {2, 1}}, nil)
+	if err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+
+	err = schema.Validate([][]int{{1, 2}, {1, 2}}, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeNotUnique {
+		t.Errorf("Expected %s error, got: %v", ErrCodeNotUnique, err)
+	}
+}
+
+func TestArraySchema_UniqueBy(t *testing.T) {
+	schema := Array(Map(map[string]Schema{
+		"id":   Int(),
+		"name": String(),
+	})).UniqueBy(func(element any) any {
+		return element.(map[string]any)["id"]
+	})
+
+	err := schema.Validate([]map[string]any{
+		{"id": 1, "name": "a"},
+		{"id": 2, "name": "a"},
+	}, nil)
+	if err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+
+	err = schema.Validate([]map[string]any{
+		{"id": 1, "name": "a"},
+		{"id": 1, "name": "b"},
+	}, nil)
+	if err == nil {
+		t.Fatal("Expected error for duplicate ids")
+	}
+	if err.Errors[0].Meta["index"] != 1 {
+		t.Errorf("Expected duplicate at index 1, got %v", err.Errors[0].Meta["index"])
+	}
+}
+
+func TestArraySchema_Includes(t *testing.T) {
+	schema := Array(String()).Includes("required")
+
+	// Valid: contains the value
+	err := schema.Validate([]string{"a", "required", "b"}, nil)
+	if err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+
+	// Invalid: value missing
+	err = schema.Validate([]string{"a", "b"}, nil)
+	if err == nil {
+		t.Fatal("Expected error for missing element")
+	}
+	if err.Errors[0].Code != ErrCodeMissingElement {
+		t.Errorf("Expected error code %s, got %s", ErrCodeMissingElement, err.Errors[0].Code)
+	}
+	if err.Errors[0].Message != "Array must include required" {
+		t.Errorf("Expected message naming the missing value, got: %s", err.Errors[0].Message)
+	}
+}
+
+func TestArraySchema_Includes_MixedIntegerTypes(t *testing.T) {
+	schema := Array(Int()).Includes(3).Includes(int64(5))
+
+	err := schema.Validate([]int8{1, 3, 5}, nil)
+	if err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+
+	err = schema.Validate([]uint16{3}, nil)
+	if err == nil {
+		t.Fatal("Expected error for missing 5")
+	}
+	if len(err.Errors) != 1 || err.Errors[0].Meta["expected"] != int64(5) {
+		t.Errorf("Expected a single error for value 5, got: %v", err.Errors)
+	}
+}
+
+func TestArraySchema_Includes_NaN(t *testing.T) {
+	if err := Array(Float()).Includes(5).Validate([]float64{math.NaN()}, nil); err == nil {
+		t.Error("Expected NaN not to count as 5")
+	}
+	if err := Array(Float()).IsPermutationOf([]any{1, 2}).Validate([]float64{math.NaN(), math.NaN()}, nil); err == nil {
+		t.Error("Expected [NaN NaN] not to be a permutation of [1 2]")
+	}
+}
+
+func TestArraySchema_IsPermutationOf(t *testing.T) {
+	schema := Array(String()).IsPermutationOf([]any{"a", "b", "c"})
+
+	// Valid: exact permutation
+	err := schema.Validate([]string{"c", "a", "b"}, nil)
+	if err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+
+	// Invalid: missing element
+	err = schema.Validate([]string{"c", "a"}, nil)
+	if err == nil {
+		t.Fatal("Expected error for missing element")
+	}
+	if err.Errors[0].Code != ErrCodeNotPermutation {
+		t.Errorf("Expected error code %s, got %s", ErrCodeNotPermutation, err.Errors[0].Code)
+	}
+	missing := err.Errors[0].Meta["missing"].([]any)
+	if len(missing) != 1 || missing[0] != "b" {
+		t.Errorf("Expected missing [b], got %v", missing)
+	}
+
+	// Invalid: duplicate in place of another element
+	err = schema.Validate([]string{"a", "a", "b"}, nil)
+	if err == nil {
+		t.Fatal("Expected error for duplicate element")
+	}
+	missing = err.Errors[0].Meta["missing"].([]any)
+	extra := err.Errors[0].Meta["extra"].([]any)
+	if len(missing) != 1 || missing[0] != "c" {
+		t.Errorf("Expected missing [c], got %v", missing)
+	}
+	if len(extra) != 1 || extra[0] != "a" {
+		t.Errorf("Expected extra [a], got %v", extra)
+	}
+}
+
+type arrayTestUser struct {
+	Name string `json:"name"`
+	Age  int    `json:"age"`
+}
+
+func TestArraySchema_StructElements(t *testing.T) {
+	schema := Array(Struct(Shape{
+		"name": String().Min(2),
+		"age":  Int().Min(0),
+	}))
+
+	// Valid: slice of struct values
+	err := schema.Validate([]arrayTestUser{{Name: "Alice", Age: 30}, {Name: "Bob", Age: 25}}, nil)
+	if err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+
+	// Invalid: second element has a bad field
+	err = schema.Validate([]arrayTestUser{{Name: "Alice", Age: 30}, {Name: "B", Age: 25}}, []any{"users"})
+	if err == nil {
+		t.Fatal("Expected error for invalid struct element")
+	}
+	expected := []any{"users", 1, "name"}
+	if !PathEqual(err.Errors[0].Path, expected) {
+		t.Errorf("Expected path %v, got %v", expected, err.Errors[0].Path)
+	}
+}
+
+func TestArraySchema_StructPointerElements(t *testing.T) {
+	schema := Array(Struct(Shape{
+		"name": String().Min(2),
+		"age":  Int().Min(0),
+	}))
+
+	// Valid: slice of struct pointers
+	err := schema.Validate([]*arrayTestUser{{Name: "Alice", Age: 30}}, nil)
+	if err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+
+	// Invalid: bad field behind a pointer
+	err = schema.Validate([]*arrayTestUser{{Name: "Alice", Age: 30}, {Name: "Bob", Age: -1}}, nil)
+	if err == nil {
+		t.Fatal("Expected error for invalid struct pointer element")
+	}
+	if !PathEqual(err.Errors[0].Path, []any{1, "age"}) {
+		t.Errorf("Expected path [1 age], got %v", err.Errors[0].Path)
+	}
+
+	// Invalid: nil pointer element is treated as a missing value
+	err = schema.Validate([]*arrayTestUser{nil}, nil)
+	if err == nil {
+		t.Fatal("Expected error for nil struct pointer element")
+	}
+	if err.Errors[0].Code != ErrCodeRequired || !PathEqual(err.Errors[0].Path, []any{0}) {
+		t.Errorf("Expected %s at [0], got %s at %v", ErrCodeRequired, err.Errors[0].Code, err.Errors[0].Path)
+	}
+}
+
+func TestArraySchema_RejectNullElements(t *testing.T) {
+	// Nilable element schema normally accepts nil elements
+	schema := Array(String().Nilable())
+	if err := schema.Validate([]any{"a", nil}, nil); err != nil {
+		t.Errorf("Expected no errors without strict mode, got: %v", err)
+	}
+
+	// Strict mode rejects them regardless of the element schema
+	schema = Array(String().Nilable()).RejectNullElements()
+	if err := schema.Validate([]any{"a", "b"}, nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+
+	err := schema.Validate([]any{"a", nil, "c", nil}, nil)
+	if err == nil {
+		t.Fatal("Expected errors for nil elements")
+	}
+	if len(err.Errors) != 2 {
+		t.Fatalf("Expected 2 errors, got %d", len(err.Errors))
+	}
+	if !PathEqual(err.Errors[0].Path, []any{1}) || !PathEqual(err.Errors[1].Path, []any{3}) {
+		t.Errorf("Expected errors at [1] and [3], got %v and %v", err.Errors[0].Path, err.Errors[1].Path)
+	}
+
+	// Nil pointers count as null too
+	var missing *arrayTestUser
+	structSchema := Array(Struct(Shape{"name": String()}).Nilable()).RejectNullElements()
+	if err := structSchema.Validate([]*arrayTestUser{missing}, nil); err == nil {
+		t.Error("Expected error for nil pointer element")
+	}
+}
+
+func TestArraySchema_Between(t *testing.T) {
+	schema := Array(Int()).Between(1, 2)
+
+	if err := schema.Validate([]int{1, 2}, nil); err != nil {
+		t.Errorf("Expected no errors for 2 items, got: %v", err)
+	}
+	err := schema.Validate([]int{}, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeTooSmall {
+		t.Errorf("Expected %s error for empty array, got: %v", ErrCodeTooSmall, err)
+	}
+	err = schema.Validate([]int{1, 2, 3}, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeTooBig {
+		t.Errorf("Expected %s error for 3 items, got: %v", ErrCodeTooBig, err)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for Between(2, 1)")
+		}
+	}()
+	Array(Int()).Between(2, 1)
+}
+
+func TestArraySchema_LengthMeta(t *testing.T) {
+	schema := Array(Int()).Min(3)
+
+	// One length error and one element error
+	err := schema.Validate([]any{1, "two"}, nil)
+	if err == nil || len(err.Errors) != 2 {
+		t.Fatalf("Expected 2 errors, got: %v", err)
+	}
+	var lengthErr *ValidationError
+	for i := range err.Errors {
+		if err.Errors[i].Meta["constraint"] == "minLength" {
+			lengthErr = &err.Errors[i]
+		}
+	}
+	if lengthErr == nil {
+		t.Fatalf("Expected an error with constraint minLength, got: %v", err)
+	}
+	if lengthErr.Meta["limit"] != 3 || lengthErr.Meta["actual"] != 2 {
+		t.Errorf("Expected limit 3 and actual 2, got: %v", lengthErr.Meta)
+	}
+
+	err = Array(Int()).Max(1).Validate([]int{1, 2}, nil)
+	if err == nil || err.Errors[0].Meta["constraint"] != "maxLength" || err.Errors[0].Meta["limit"] != 1 {
+		t.Errorf("Expected maxLength meta, got: %v", err)
+	}
+
+	err = Array(Int()).NonEmpty().Validate([]int{}, nil)
+	if err == nil || err.Errors[0].Meta["constraint"] != "nonEmpty" {
+		t.Errorf("Expected nonEmpty meta, got: %v", err)
+	}
+}
+
+type testScores []int
+
+type testPoint struct {
+	X int `json:"x"`
+}
+
+func TestArraySchema_SliceKinds(t *testing.T) {
+	ints := Array(Int().Positive())
+	valid := map[string]any{
+		"[]any":               []any{1, 2, 3},
+		"[]int":               []int{1, 2, 3},
+		"[3]int":              [3]int{1, 2, 3},
+		"named slice":         testScores{1, 2, 3},
+		"[]uint8":             []uint8{1, 2, 3},
+		"pointer to slice":    &[]int{1, 2, 3},
+		"pointer to array":    &[2]int64{1, 2},
+		"[]any of mixed ints": []any{int8(1), uint16(2), int64(3)},
+	}
+	for name, value := range valid {
+		if err := ints.Validate(value, nil); err != nil {
+			t.Errorf("%s: expected no errors, got: %v", name, err)
+		}
+	}
+
+	// Element errors keep their index for every slice kind
+	for name, value := range map[string]any{"[]any": []any{1, -2}, "[2]int": [2]int{1, -2}, "named slice": testScores{1, -2}} {
+		err := ints.Validate(value, nil)
+		if err == nil || !PathEqual(err.Errors[0].Path, []any{1}) {
+			t.Errorf("%s: expected error at [1], got: %v", name, err)
+		}
+	}
+
+	// Slices of structs and of interfaces
+	points := Array(Struct(Shape{"x": Int().Min(0)}))
+	if err := points.Validate([]testPoint{{X: 1}, {X: 2}}, nil); err != nil {
+		t.Errorf("Expected no errors for []struct, got: %v", err)
+	}
+	if err := points.Validate([]testPoint{{X: 1}, {X: -1}}, nil); err == nil || !PathEqual(err.Errors[0].Path, []any{1, "x"}) {
+		t.Errorf("Expected error at [1].x, got: %v", err)
+	}
+	if err := Array(String()).Validate([]interface{ String() string }{testEmail{"a", "b.c"}}, nil); err == nil {
+		t.Error("Expected Stringer elements to be rejected without AcceptStringer")
+	}
+
+	for name, value := range map[string]any{"map": map[string]int{"a": 1}, "nil pointer": (*[]int)(nil), "string": "abc"} {
+		if err := ints.Validate(value, nil); err == nil || err.Errors[0].Code != ErrCodeInvalidType {
+			t.Errorf("%s: expected invalid_type error, got: %v", name, err)
+		}
+	}
+}
+
+func TestArraySchema_SomeEvery(t *testing.T) {
+	positive := func(v any) bool { return v.(int) > 0 }
+
+	some := Array(Int()).Some(positive, "At least one amount must be positive")
+	if err := some.Validate([]int{-1, 0, 3}, nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+	for _, value := range [][]int{{-1, 0}, {}} {
+		err := some.Validate(value, []any{"amounts"})
+		if err == nil || len(err.Errors) != 1 {
+			t.Fatalf("Expected one error for %v, got: %v", value, err)
+		}
+		e := err.Errors[0]
+		if e.Code != ErrCodeCustomValidation || e.Message != "At least one amount must be positive" || e.Meta["constraint"] != "some" || PathToString(e.Path) != "amounts" {
+			t.Errorf("Unexpected error for %v: %+v", value, e)
+		}
+	}
+
+	every := Array(Int()).Every(positive, "")
+	if err := every.Validate([]int{}, nil); err != nil {
+		t.Errorf("Expected an empty array to pass, got: %v", err)
+	}
+	err := every.Validate([]int{1, -2, 3, 0}, nil)
+	if err == nil || len(err.Errors) != 1 {
+		t.Fatalf("Expected one aggregated error, got: %v", err)
+	}
+	e := err.Errors[0]
+	if e.Message != "All elements must satisfy the condition" || e.Meta["constraint"] != "every" {
+		t.Errorf("Unexpected error: %+v", e)
+	}
+	if indexes, _ := e.Meta["indexes"].([]int); len(indexes) != 2 || indexes[0] != 1 || indexes[1] != 3 {
+		t.Errorf("Expected indexes [1 3], got %v", e.Meta["indexes"])
+	}
+
+	// Predicates only run once every element passed, so they can assume well-typed values
+	err = some.Validate([]any{"x", -1}, nil)
+	if err == nil || len(err.Errors) != 1 || err.Errors[0].Code != ErrCodeInvalidType {
+		t.Errorf("Expected only the element error, got: %v", err)
+	}
+
+	// An explicit message is kept over a global default; the built-in one is not
+	SetDefaultMessage(ErrCodeCustomValidation, "Something is off")
+	t.Cleanup(func() { SetDefaultMessage(ErrCodeCustomValidation, "") })
+	if err := some.Validate([]int{-1}, nil); err == nil || err.Errors[0].Message != "At least one amount must be positive" {
+		t.Errorf("Expected the explicit message, got: %v", err)
+	}
+	if err := every.Validate([]int{-1}, nil); err == nil || err.Errors[0].Message != "Something is off" {
+		t.Errorf("Expected the global default message, got: %v", err)
+	}
+
+	// Predicates added to a clone do not affect the original
+	every.Clone().Some(func(v any) bool { return v.(int) > 100 }, "")
+	if err := every.Validate([]int{1}, nil); err != nil {
+		t.Errorf("Expected clone to be independent, got: %v", err)
+	}
+}
+
+func TestArraySchema_MaxItems(t *testing.T) {
+	calls := 0
+	schema := Array(Int().Refine(func(any) (bool, string) {
+		calls++
+		return true, ""
+	})).Max(2).MaxItems(3)
+
+	err := schema.Validate([]int{1, 2, 3}, nil)
+	if err == nil || len(err.Errors) != 1 || err.Errors[0].Code != ErrCodeTooBig {
+		t.Errorf("Expected only the Max error below the ceiling, got: %v", err)
+	}
+
+	for _, value := range []any{[]int{1, 2, 3, 4}, []any{1, 2, 3, 4}, &[4]int{}} {
+		calls = 0
+		err := schema.Validate(value, []any{"ids"})
+		if err == nil || len(err.Errors) != 1 {
+			t.Fatalf("Expected a single error for %T, got: %v", value, err)
+		}
+		e := err.Errors[0]
+		if e.Code != ErrCodeResourceLimit || e.Meta["limit"] != 3 || e.Meta["actual"] != 4 || PathToString(e.Path) != "ids" {
+			t.Errorf("Unexpected error for %T: %+v", value, e)
+		}
+		if calls != 0 {
+			t.Errorf("Expected no element validation for %T, got %d calls", value, calls)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package gozod
+
+import (
+	"context"
+	"testing"
+)
+
+type testCtxKey string
+
+func TestAsyncRefine_StringSchema(t *testing.T) {
+	taken := map[string]bool{"admin": true}
+	schema := String().AsyncRefine(func(ctx context.Context, value any) (bool, string) {
+		return !taken[value.(string)], "Username is already taken"
+	})
+
+	// Valid: username not taken
+	err := schema.ValidateCtx(context.Background(), "alice", nil)
+	if err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+
+	// Invalid: username taken
+	err = schema.ValidateCtx(context.Background(), "admin", nil)
+	if err == nil {
+		t.Fatal("Expected error for taken username")
+	}
+	if err.Errors[0].Code != ErrCodeCustomValidation {
+		t.Errorf("Expected error code %s, got %s", ErrCodeCustomValidation, err.Errors[0].Code)
+	}
+	if err.Errors[0].Message != "Username is already taken" {
+		t.Errorf("Expected custom message, got: %s", err.Errors[0].Message)
+	}
+
+	// Validate is a thin wrapper around ValidateCtx
+	err = schema.Validate("admin", nil)
+	if err == nil {
+		t.Error("Expected error for taken username via Validate")
+	}
+}
+
+func TestAsyncRefine_CanceledContext(t *testing.T) {
+	called := false
+	schema := Int().AsyncRefine(func(ctx context.Context, value any) (bool, string) {
+		called = true
+		return true, ""
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := schema.ValidateCtx(ctx, 42, nil)
+	if err == nil {
+		t.Fatal("Expected error for canceled context")
+	}
+	if err.Errors[0].Code != ErrCodeCanceled {
+		t.Errorf("Expected error code %s, got %s", ErrCodeCanceled, err.Errors[0].Code)
+	}
+	if called {
+		t.Error("Expected async refinement not to run on a canceled context")
+	}
+}
+
+func TestAsyncRefine_ContextPropagatesToNestedSchemas(t *testing.T) {
+	key := testCtxKey("tenant")
+	schema := Map(map[string]Schema{
+		"tags": Array(String().AsyncRefine(func(ctx context.Context, value any) (bool, string) {
+			return ctx.Value(key) == "acme", "Wrong tenant"
+		})),
+	})
+	data := map[string]any{"tags": []string{"a", "b"}}
+
+	ctx := context.WithValue(context.Background(), key, "acme")
+	if err := schema.ValidateCtx(ctx, data, nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+
+	err := schema.ValidateCtx(context.Background(), data, nil)
+	if err == nil {
+		t.Fatal("Expected errors without tenant in context")
+	}
+	if len(err.Errors) != 2 {
+		t.Errorf("Expected 2 errors, got %d", len(err.Errors))
+	}
+	if !PathEqual(err.Errors[0].Path, []any{"tags", 0}) {
+		t.Errorf("Expected path [tags 0], got %v", err.Errors[0].Path)
+	}
+}
+
+func TestSuperRefineContext_Context(t *testing.T) {
+	key := testCtxKey("limit")
+	schema := Int().SuperRefine(func(value any, ctx *SuperRefineContext) {
+		if limit, ok := ctx.Context().Value(key).(int); ok && value.(int) > limit {
+			ctx.AddIssue(nil, ErrCodeTooBig, "Over the request limit")
+		}
+	})
+
+	ctx := context.WithValue(context.Background(), key, 10)
+	if err := schema.ValidateCtx(ctx, 5, nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+	if err := schema.ValidateCtx(ctx, 50, nil); err == nil {
+		t.Error("Expected error for value above request limit")
+	}
+}
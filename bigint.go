@@ -0,0 +1,273 @@
+package gozod
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+)
+
+// BigIntSchema validates arbitrary-precision integers
+type BigIntSchema struct {
+	BaseSchema
+	min      *big.Int
+	max      *big.Int
+	positive bool
+	negative bool
+}
+
+// BigInt creates a new arbitrary-precision integer schema
+// It accepts *big.Int, big.Int, Go integer types and base-10 numeric strings (e.g. "18446744073709551616")
+func BigInt() *BigIntSchema {
+	return &BigIntSchema{
+		BaseSchema: BaseSchema{required: true},
+	}
+}
+
+// Nilable allows null values
+func (s *BigIntSchema) Nilable() *BigIntSchema {
+	s.nilable = true
+	return s
+}
+
+// Optional allows missing values without declaring null a valid value
+// Validation accepts nil as with Nilable; the difference shows in IsOptional and IsNilable
+func (s *BigIntSchema) Optional() *BigIntSchema {
+	s.required = false
+	return s
+}
+
+// Min sets the minimum value (inclusive)
+func (s *BigIntSchema) Min(value *big.Int) *BigIntSchema {
+	s.min = new(big.Int).Set(value)
+	return s
+}
+
+// Max sets the maximum value (inclusive)
+func (s *BigIntSchema) Max(value *big.Int) *BigIntSchema {
+	s.max = new(big.Int).Set(value)
+	return s
+}
+
+// Positive validates that the number is positive (> 0)
+func (s *BigIntSchema) Positive() *BigIntSchema {
+	s.positive = true
+	return s
+}
+
+// Negative validates that the number is negative (< 0)
+func (s *BigIntSchema) Negative() *BigIntSchema {
+	s.negative = true
+	return s
+}
+
+// Refine adds a custom validation function
+// The function receives the value and returns (isValid, errorMessage)
+// If isValid is false, validation fails with the provided errorMessage
+// If errorMessage is empty, a default message will be used
+// Options such as OnlyIfValid and AbortOnFailure control when it runs
+func (s *BigIntSchema) Refine(validator RefineFunc, opts ...RefineOption) *BigIntSchema {
+	s.BaseSchema.addRefinement(validator, opts...)
+	return s
+}
+
+// UseRefinement attaches a refinement registered with RegisterRefinement
+// It panics if no refinement is registered under name
+func (s *BigIntSchema) UseRefinement(name string) *BigIntSchema {
+	s.BaseSchema.useRefinement(name)
+	return s
+}
+
+// SuperRefine adds a super refinement validation function
+// Similar to Refine, but provides a context object for adding errors with custom paths and codes
+// This allows for more fine-grained control over error reporting, similar to Zod's superRefine
+func (s *BigIntSchema) SuperRefine(validator SuperRefineFunc) *BigIntSchema {
+	s.BaseSchema.addSuperRefinement(validator)
+	return s
+}
+
+// AsyncRefine adds a context-aware validation function
+// The function receives the context passed to ValidateCtx and is awaited before validation returns
+// Use it for expensive checks (e.g. database lookups) that need cancellation or deadlines
+func (s *BigIntSchema) AsyncRefine(validator AsyncRefineFunc) *BigIntSchema {
+	s.BaseSchema.addAsyncRefinement(validator)
+	return s
+}
+
+// RefineWithParent adds a validation function that also receives the enclosing object
+// It runs when the schema validates a field of a Map or Struct and the field itself is valid,
+// including a nil value on a Nilable field, which makes it suitable for conditionally required fields
+func (s *BigIntSchema) RefineWithParent(validator ParentRefineFunc) *BigIntSchema {
+	s.BaseSchema.addParentRefinement(validator)
+	return s
+}
+
+// Or returns a union that accepts values matching either s or other
+func (s *BigIntSchema) Or(other Schema) *UnionSchema {
+	return Union(s, other)
+}
+
+// And returns an intersection that requires values to match both s and other
+func (s *BigIntSchema) And(other Schema) *IntersectionSchema {
+	return Intersection(s, other)
+}
+
+// Validate validates a value against the bigint schema
+func (s *BigIntSchema) Validate(value any, path []any) *ValidationErrors {
+	return s.ValidateCtx(context.Background(), value, path)
+}
+
+// ValidateCtx validates a value using ctx for cancellation and request scoping
+func (s *BigIntSchema) ValidateCtx(ctx context.Context, value any, path []any) *ValidationErrors {
+	_, errs := s.parse(ctx, value, path)
+	return errs
+}
+
+// parse validates value and returns it as a *big.Int
+func (s *BigIntSchema) parse(ctx context.Context, value any, path []any) (any, *ValidationErrors) {
+	// Errors are collected in a local value and only escape to the heap when validation fails
+	var errors ValidationErrors
+
+	if v, ok := value.(*big.Int); ok && v == nil {
+		value = nil
+	}
+
+	// Handle nil/nilable
+	if value == nil {
+		if !s.allowsNil() {
+			msg := s.getErrorMessage(path, ErrCodeRequired, "Required")
+			errors.Add(path, ErrCodeRequired, msg)
+		}
+		return nil, errors.orNil()
+	}
+
+	num, ok := toBigInt(value)
+	if !ok {
+		msg := s.getErrorMessage(path, ErrCodeInvalidType, fmt.Sprintf("Expected big integer, got %T", value))
+		errors.Add(path, ErrCodeInvalidType, msg)
+		return nil, errors.orNil()
+	}
+
+	// Min validation
+	if s.min != nil && num.Cmp(s.min) < 0 {
+		meta := map[string]any{"minimum": new(big.Int).Set(s.min), "inclusive": true, "actual": num}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeTooSmall, fmt.Sprintf("Number must be greater than or equal to %s, got %s", s.min, num), meta)
+		errors.AddWithMeta(path, ErrCodeTooSmall, msg, meta)
+	}
+
+	// Max validation
+	if s.max != nil && num.Cmp(s.max) > 0 {
+		meta := map[string]any{"maximum": new(big.Int).Set(s.max), "inclusive": true, "actual": num}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeTooBig, fmt.Sprintf("Number must be less than or equal to %s, got %s", s.max, num), meta)
+		errors.AddWithMeta(path, ErrCodeTooBig, msg, meta)
+	}
+
+	// Positive validation
+	if s.positive && num.Sign() <= 0 {
+		msg := s.getErrorMessage(path, ErrCodeNotPositive, fmt.Sprintf("Number must be positive (> 0), got %s", num))
+		errors.Add(path, ErrCodeNotPositive, msg)
+	}
+
+	// Negative validation
+	if s.negative && num.Sign() >= 0 {
+		msg := s.getErrorMessage(path, ErrCodeNotNegative, fmt.Sprintf("Number must be negative (< 0), got %s", num))
+		errors.Add(path, ErrCodeNotNegative, msg)
+	}
+
+	// Apply custom refinements (only if type check passed)
+	s.applyRefinements(num, path, &errors)
+
+	// Apply async refinements (only if type check passed)
+	s.applyAsyncRefinements(ctx, num, path, &errors)
+
+	// Apply super refinements (only if type check passed)
+	s.applySuperRefinements(ctx, num, path, &errors)
+
+	if len(errors.Errors) > 0 {
+		return nil, errors.orNil()
+	}
+	return num, nil
+}
+
+// toBigInt converts supported inputs to a *big.Int
+// Inputs that are already *big.Int are returned as is
+func toBigInt(value any) (*big.Int, bool) {
+	switch v := value.(type) {
+	case *big.Int:
+		return v, true
+	case big.Int:
+		return &v, true
+	case string:
+		return new(big.Int).SetString(v, 10)
+	case json.Number:
+		return new(big.Int).SetString(v.String(), 10)
+	case int:
+		return big.NewInt(int64(v)), true
+	case int8:
+		return big.NewInt(int64(v)), true
+	case int16:
+		return big.NewInt(int64(v)), true
+	case int32:
+		return big.NewInt(int64(v)), true
+	case int64:
+		return big.NewInt(v), true
+	case uint:
+		return new(big.Int).SetUint64(uint64(v)), true
+	case uint8:
+		return new(big.Int).SetUint64(uint64(v)), true
+	case uint16:
+		return new(big.Int).SetUint64(uint64(v)), true
+	case uint32:
+		return new(big.Int).SetUint64(uint64(v)), true
+	case uint64:
+		return new(big.Int).SetUint64(v), true
+	}
+	return nil, false
+}
+
+// Title sets a short human-readable title used in generated documentation
+func (s *BigIntSchema) Title(title string) *BigIntSchema {
+	s.annotations.Title = title
+	return s
+}
+
+// Describe sets a description used in generated documentation
+// It does not affect validation
+func (s *BigIntSchema) Describe(description string) *BigIntSchema {
+	s.annotations.Description = description
+	return s
+}
+
+// Example adds an example value used in generated documentation
+func (s *BigIntSchema) Example(example any) *BigIntSchema {
+	s.annotations.Examples = append(s.annotations.Examples, example)
+	return s
+}
+
+// Clone returns an independent copy of the schema
+// Constraints, refinements and custom errors are copied, so changing the clone does not affect s
+func (s *BigIntSchema) Clone() *BigIntSchema {
+	c := *s
+	c.BaseSchema = s.BaseSchema.clone()
+	return &c
+}
+
+// CustomError sets a custom error message for a specific error code
+func (s *BigIntSchema) CustomError(code, message string) *BigIntSchema {
+	if s.BaseSchema.customErrors == nil {
+		s.BaseSchema.customErrors = make(map[string]string)
+	}
+	s.BaseSchema.customErrors[code] = message
+	return s
+}
+
+// SetErrorFormatter sets a custom error formatter function
+func (s *BigIntSchema) SetErrorFormatter(formatter CustomErrorFunc) *BigIntSchema {
+	s.BaseSchema.errorFormatter = formatter
+	return s
+}
+
+// Type returns the schema type
+func (s *BigIntSchema) Type() string {
+	return "bigint"
+}
@@ -0,0 +1,77 @@
+package gozod
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestBigIntSchema_Types(t *testing.T) {
+	schema := BigInt()
+
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	for _, value := range []any{huge, *huge, "123456789012345678901234567890", "-42", 42, uint64(math.MaxUint64)} {
+		if err := schema.Validate(value, nil); err != nil {
+			t.Errorf("Expected no errors for %v, got: %v", value, err)
+		}
+	}
+	for _, value := range []any{"12.5", "abc", "", 1.5, true} {
+		err := schema.Validate(value, nil)
+		if err == nil || err.Errors[0].Code != ErrCodeInvalidType {
+			t.Errorf("Expected %s error for %v, got: %v", ErrCodeInvalidType, value, err)
+		}
+	}
+
+	err := schema.Validate((*big.Int)(nil), nil)
+	if err == nil || err.Errors[0].Code != ErrCodeRequired {
+		t.Errorf("Expected %s error for nil *big.Int, got: %v", ErrCodeRequired, err)
+	}
+	if err := BigInt().Nilable().Validate(nil, nil); err != nil {
+		t.Errorf("Expected no errors for nil with Nilable, got: %v", err)
+	}
+}
+
+func TestBigIntSchema_MinMax(t *testing.T) {
+	maxInt64 := big.NewInt(math.MaxInt64)
+	schema := BigInt().Min(big.NewInt(0)).Max(new(big.Int).Mul(maxInt64, big.NewInt(4)))
+
+	if err := schema.Validate(uint64(math.MaxUint64), nil); err != nil {
+		t.Errorf("Expected no errors for MaxUint64, got: %v", err)
+	}
+	err := schema.Validate("-1", nil)
+	if err == nil || err.Errors[0].Code != ErrCodeTooSmall {
+		t.Errorf("Expected %s error, got: %v", ErrCodeTooSmall, err)
+	}
+	err = schema.Validate("99999999999999999999999999", nil)
+	if err == nil || err.Errors[0].Code != ErrCodeTooBig {
+		t.Errorf("Expected %s error, got: %v", ErrCodeTooBig, err)
+	}
+}
+
+func TestBigIntSchema_PositiveNegative(t *testing.T) {
+	if err := BigInt().Positive().Validate("0", nil); err == nil || err.Errors[0].Code != ErrCodeNotPositive {
+		t.Errorf("Expected %s error for zero with Positive(), got: %v", ErrCodeNotPositive, err)
+	}
+	if err := BigInt().Negative().Validate("-1", nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+	if err := BigInt().Negative().Validate(0, nil); err == nil || err.Errors[0].Code != ErrCodeNotNegative {
+		t.Errorf("Expected %s error for zero with Negative(), got: %v", ErrCodeNotNegative, err)
+	}
+}
+
+func TestBigIntSchema_Parse(t *testing.T) {
+	n, errs := Parse[*big.Int](BigInt(), "18446744073709551616")
+	if errs != nil {
+		t.Fatalf("Expected no errors, got: %v", errs)
+	}
+	if n.String() != "18446744073709551616" {
+		t.Errorf("Expected 18446744073709551616, got %s", n)
+	}
+}
+
+func TestBigIntSchema_Type(t *testing.T) {
+	if BigInt().Type() != "bigint" {
+		t.Errorf("Expected type 'bigint', got '%s'", BigInt().Type())
+	}
+}
@@ -1,10 +1,16 @@
 package gozod
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+)
 
 // BoolSchema validates boolean values
 type BoolSchema struct {
 	BaseSchema
+	coerce bool // Convert common truthy/falsy strings and numbers to bool
 }
 
 // Bool creates a new boolean schema
@@ -20,12 +26,36 @@ func (s *BoolSchema) Nilable() *BoolSchema {
 	return s
 }
 
+// Optional allows missing values without declaring null a valid value
+// Validation accepts nil as with Nilable; the difference shows in IsOptional and IsNilable
+func (s *BoolSchema) Optional() *BoolSchema {
+	s.required = false
+	return s
+}
+
+// Coerce converts common truthy/falsy inputs to bool before validation
+// Accepted strings (case-insensitive, surrounding spaces ignored) are "true", "1", "yes", "y", "on", "t"
+// and "false", "0", "no", "n", "off", "f"; numbers are accepted when they equal 0 or 1
+// Other values fail with ErrCodeInvalidType; Parse returns the coerced bool
+func (s *BoolSchema) Coerce() *BoolSchema {
+	s.coerce = true
+	return s
+}
+
 // Refine adds a custom validation function
 // The function receives the value and returns (isValid, errorMessage)
 // If isValid is false, validation fails with the provided errorMessage
 // If errorMessage is empty, a default message will be used
-func (s *BoolSchema) Refine(validator RefineFunc) *BoolSchema {
-	s.BaseSchema.addRefinement(validator)
+// Options such as OnlyIfValid and AbortOnFailure control when it runs
+func (s *BoolSchema) Refine(validator RefineFunc, opts ...RefineOption) *BoolSchema {
+	s.BaseSchema.addRefinement(validator, opts...)
+	return s
+}
+
+// UseRefinement attaches a refinement registered with RegisterRefinement
+// It panics if no refinement is registered under name
+func (s *BoolSchema) UseRefinement(name string) *BoolSchema {
+	s.BaseSchema.useRefinement(name)
 	return s
 }
 
@@ -37,39 +67,139 @@ func (s *BoolSchema) SuperRefine(validator SuperRefineFunc) *BoolSchema {
 	return s
 }
 
+// AsyncRefine adds a context-aware validation function
+// The function receives the context passed to ValidateCtx and is awaited before validation returns
+// Use it for expensive checks (e.g. database lookups) that need cancellation or deadlines
+func (s *BoolSchema) AsyncRefine(validator AsyncRefineFunc) *BoolSchema {
+	s.BaseSchema.addAsyncRefinement(validator)
+	return s
+}
+
+// RefineWithParent adds a validation function that also receives the enclosing object
+// It runs when the schema validates a field of a Map or Struct and the field itself is valid,
+// including a nil value on a Nilable field, which makes it suitable for conditionally required fields
+func (s *BoolSchema) RefineWithParent(validator ParentRefineFunc) *BoolSchema {
+	s.BaseSchema.addParentRefinement(validator)
+	return s
+}
+
+// Or returns a union that accepts values matching either s or other
+func (s *BoolSchema) Or(other Schema) *UnionSchema {
+	return Union(s, other)
+}
+
+// And returns an intersection that requires values to match both s and other
+func (s *BoolSchema) And(other Schema) *IntersectionSchema {
+	return Intersection(s, other)
+}
+
 // Validate validates a value against the boolean schema
 func (s *BoolSchema) Validate(value any, path []any) *ValidationErrors {
-	errors := &ValidationErrors{}
+	return s.ValidateCtx(context.Background(), value, path)
+}
+
+// ValidateCtx validates a value using ctx for cancellation and request scoping
+func (s *BoolSchema) ValidateCtx(ctx context.Context, value any, path []any) *ValidationErrors {
+	// Errors are collected in a local value and only escape to the heap when validation fails
+	var errors ValidationErrors
 
 	// Handle nil/nilable
 	// Only nilable allows explicit nil values
 	if value == nil {
-		if !s.nilable {
+		if !s.allowsNil() {
 			msg := s.getErrorMessage(path, ErrCodeRequired, "Required")
 			errors.Add(path, ErrCodeRequired, msg)
-			return errors
+			return errors.orNil()
 		}
 		return nil
 	}
 
+	if s.coerce {
+		value = coerceBool(value)
+	}
+
 	// Type check
 	_, ok := value.(bool)
 	if !ok {
 		msg := s.getErrorMessage(path, ErrCodeInvalidType, fmt.Sprintf("Expected boolean, got %T", value))
 		errors.Add(path, ErrCodeInvalidType, msg)
-		return errors
+		return errors.orNil()
 	}
 
 	// Apply custom refinements (only if type check passed)
-	s.applyRefinements(value, path, errors)
+	s.applyRefinements(value, path, &errors)
+
+	// Apply async refinements (only if type check passed)
+	s.applyAsyncRefinements(ctx, value, path, &errors)
 
 	// Apply super refinements (only if type check passed)
-	s.applySuperRefinements(value, path, errors)
+	s.applySuperRefinements(ctx, value, path, &errors)
 
-	if len(errors.Errors) == 0 {
-		return nil
+	return errors.orNil()
+}
+
+// parse validates value and returns it, converted to bool if Coerce is set
+func (s *BoolSchema) parse(ctx context.Context, value any, path []any) (any, *ValidationErrors) {
+	if s.coerce {
+		value = coerceBool(value)
+	}
+	if errs := s.ValidateCtx(ctx, value, path); errs != nil {
+		return nil, errs
+	}
+	return value, nil
+}
+
+// coerceBool converts truthy/falsy strings and 0/1 numbers to bool
+// Unrecognized values are returned unchanged so the type check reports them
+func coerceBool(value any) any {
+	switch v := value.(type) {
+	case string:
+		switch strings.ToLower(strings.TrimSpace(v)) {
+		case "true", "1", "yes", "y", "on", "t":
+			return true
+		case "false", "0", "no", "n", "off", "f":
+			return false
+		}
+		return value
+	case bool, nil:
+		return value
+	}
+	if rv := reflect.ValueOf(value); numericKind(rv.Kind()) != 0 {
+		switch toFloat64(rv) {
+		case 1:
+			return true
+		case 0:
+			return false
+		}
 	}
-	return errors
+	return value
+}
+
+// Title sets a short human-readable title used in generated documentation
+func (s *BoolSchema) Title(title string) *BoolSchema {
+	s.annotations.Title = title
+	return s
+}
+
+// Describe sets a description used in generated documentation
+// It does not affect validation
+func (s *BoolSchema) Describe(description string) *BoolSchema {
+	s.annotations.Description = description
+	return s
+}
+
+// Example adds an example value used in generated documentation
+func (s *BoolSchema) Example(example any) *BoolSchema {
+	s.annotations.Examples = append(s.annotations.Examples, example)
+	return s
+}
+
+// Clone returns an independent copy of the schema
+// Constraints, refinements and custom errors are copied, so changing the clone does not affect s
+func (s *BoolSchema) Clone() *BoolSchema {
+	c := *s
+	c.BaseSchema = s.BaseSchema.clone()
+	return &c
 }
 
 // CustomError sets a custom error message for a specific error code
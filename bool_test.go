@@ -123,3 +123,41 @@ func TestBoolSchema_TrueAndFalse(t *testing.T) {
 		t.Errorf("Expected false to be valid, got: %v", err)
 	}
 }
+
+func TestBoolSchema_Coerce(t *testing.T) {
+	schema := Bool().Coerce()
+
+	truthy := []any{"true", "TRUE", " yes ", "1", "on", "y", 1, int64(1), uint8(1), 1.0, true}
+	for _, value := range truthy {
+		b, err := Parse[bool](schema, value)
+		if err != nil || !b {
+			t.Errorf("Expected %v to coerce to true, got %v (%v)", value, b, err)
+		}
+	}
+	falsy := []any{"false", "False", "no", "0", "off", "n", 0, 0.0, false}
+	for _, value := range falsy {
+		b, err := Parse[bool](schema, value)
+		if err != nil || b {
+			t.Errorf("Expected %v to coerce to false, got %v (%v)", value, b, err)
+		}
+	}
+	for _, value := range []any{"maybe", "", 2, -1, 0.5, []any{}} {
+		err := schema.Validate(value, nil)
+		if err == nil || err.Errors[0].Code != ErrCodeInvalidType {
+			t.Errorf("Expected %s error for %v, got: %v", ErrCodeInvalidType, value, err)
+		}
+	}
+
+	// Refinements see the coerced value
+	mustBeTrue := Bool().Coerce().Refine(func(value any) (bool, string) {
+		return value.(bool), "Must be accepted"
+	})
+	if err := mustBeTrue.Validate("yes", nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+
+	// Strict by default
+	if err := Bool().Validate("true", nil); err == nil {
+		t.Error("Expected error for string without Coerce")
+	}
+}
@@ -0,0 +1,96 @@
+package gozod
+
+import (
+	"unicode/utf8"
+)
+
+// isASCII reports whether str only contains ASCII characters (U+0000 to U+007F)
+func isASCII(str string) bool {
+	for i := 0; i < len(str); i++ {
+		if str[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// isPrintableASCII reports whether str only contains printable ASCII characters,
+// from the space (U+0020) to the tilde (U+007E)
+func isPrintableASCII(str string) bool {
+	for i := 0; i < len(str); i++ {
+		if str[i] < ' ' || str[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// emojiRanges are the code point ranges of pictographic emoji
+// They cover the emoji blocks and the emoji presentations of older symbols
+var emojiRanges = [][2]rune{
+	{0x00A9, 0x00A9}, {0x00AE, 0x00AE}, {0x203C, 0x203C}, {0x2049, 0x2049},
+	{0x2122, 0x2122}, {0x2139, 0x2139}, {0x2194, 0x2199}, {0x21A9, 0x21AA},
+	{0x231A, 0x231B}, {0x2328, 0x2328}, {0x23CF, 0x23CF}, {0x23E9, 0x23F3},
+	{0x23F8, 0x23FA}, {0x24C2, 0x24C2}, {0x25AA, 0x25AB}, {0x25B6, 0x25B6},
+	{0x25C0, 0x25C0}, {0x25FB, 0x25FE}, {0x2600, 0x27BF}, {0x2934, 0x2935},
+	{0x2B05, 0x2B07}, {0x2B1B, 0x2B1C}, {0x2B50, 0x2B50}, {0x2B55, 0x2B55},
+	{0x3030, 0x3030}, {0x303D, 0x303D}, {0x3297, 0x3297}, {0x3299, 0x3299},
+	{0x1F000, 0x1F0FF}, {0x1F10D, 0x1F1FF}, {0x1F200, 0x1F2FF}, {0x1F300, 0x1F64F},
+	{0x1F680, 0x1F6FF}, {0x1F7E0, 0x1F7F0}, {0x1F900, 0x1F9FF}, {0x1FA70, 0x1FAFF},
+}
+
+// isEmojiRune reports whether r is a pictographic emoji code point
+func isEmojiRune(r rune) bool {
+	for _, rng := range emojiRanges {
+		if r >= rng[0] && r <= rng[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// isEmojiComponent reports whether r only modifies or joins emoji: the zero width joiner,
+// variation selectors, skin tone modifiers, the keycap mark and tag characters
+func isEmojiComponent(r rune) bool {
+	switch {
+	case r == 0x200D, r == 0xFE0E, r == 0xFE0F, r == 0x20E3:
+		return true
+	case r >= 0x1F3FB && r <= 0x1F3FF:
+		return true
+	case r >= 0xE0020 && r <= 0xE007F:
+		return true
+	}
+	return false
+}
+
+// isEmoji reports whether str is non-empty and composed solely of emoji
+// ZWJ sequences, flags, skin tones and keycaps (e.g. "1️⃣") are accepted,
+// but components alone (e.g. a lone joiner or skin tone modifier) are not
+func isEmoji(str string) bool {
+	hasBase := false
+	for i := 0; i < len(str); {
+		r, size := utf8.DecodeRuneInString(str[i:])
+		switch {
+		case r == utf8.RuneError && size == 1:
+			return false
+		case isEmojiComponent(r):
+		case isEmojiRune(r):
+			hasBase = true
+		case r == '#' || r == '*' || (r >= '0' && r <= '9'):
+			hasBase = true
+			// Keycap bases only count as emoji when followed by the keycap mark
+			rest := str[i+size:]
+			next, n := utf8.DecodeRuneInString(rest)
+			if next == 0xFE0F {
+				next, _ = utf8.DecodeRuneInString(rest[n:])
+			}
+			if next != 0x20E3 {
+				return false
+			}
+		default:
+			return false
+		}
+		i += size
+	}
+	return hasBase
+}
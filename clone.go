@@ -0,0 +1,72 @@
+package gozod
+
+import (
+	"maps"
+	"slices"
+)
+
+// clone returns a copy of b whose slices and maps are not shared with b
+func (b BaseSchema) clone() BaseSchema {
+	b.customErrors = maps.Clone(b.customErrors)
+	b.refinements = slices.Clone(b.refinements)
+	b.superRefinements = slices.Clone(b.superRefinements)
+	b.asyncRefinements = slices.Clone(b.asyncRefinements)
+	b.parentRefinements = slices.Clone(b.parentRefinements)
+	b.refinementNames = slices.Clone(b.refinementNames)
+	b.annotations.Examples = slices.Clone(b.annotations.Examples)
+	return b
+}
+
+// cloneShape deep-copies the schemas of a shape
+func cloneShape(shape map[string]Schema) map[string]Schema {
+	if shape == nil {
+		return nil
+	}
+	cloned := make(map[string]Schema, len(shape))
+	for name, schema := range shape {
+		cloned[name] = CloneSchema(schema)
+	}
+	return cloned
+}
+
+// CloneSchema returns an independent deep copy of s, including nested schemas
+// Lazy and Validated schemas are returned as is: they hold no constraints, and sharing
+// a Lazy keeps recursive schemas finite; schemas defined outside this package are also returned as is
+func CloneSchema(s Schema) Schema {
+	switch s := s.(type) {
+	case *StringSchema:
+		return s.Clone()
+	case *IntSchema:
+		return s.Clone()
+	case *FloatSchema:
+		return s.Clone()
+	case *BoolSchema:
+		return s.Clone()
+	case *BigIntSchema:
+		return s.Clone()
+	case *DateSchema:
+		return s.Clone()
+	case *DurationSchema:
+		return s.Clone()
+	case *ArraySchema:
+		return s.Clone()
+	case *MapSchema:
+		return s.Clone()
+	case *StructSchema:
+		return s.Clone()
+	case *UnionSchema:
+		return s.Clone()
+	case *IntersectionSchema:
+		return s.Clone()
+	case *LiteralSchema:
+		return s.Clone()
+	case *NullSchema:
+		return s.Clone()
+	case *AnySchema:
+		return s.Clone()
+	case *WhenSchema:
+		return s.Clone()
+	default:
+		return s
+	}
+}
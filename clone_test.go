@@ -0,0 +1,83 @@
+package gozod
+
+import "testing"
+
+func TestClone_Independent(t *testing.T) {
+	original := String().Min(3).CustomError(ErrCodeTooSmall, "Too short")
+	clone := original.Clone().Max(5).CustomError(ErrCodeTooSmall, "Way too short").
+		Refine(func(value any) (bool, string) { return value != "bad", "Bad value" })
+
+	// The original is unchanged
+	if err := original.Validate("abcdefgh", nil); err != nil {
+		t.Errorf("Expected original without Max, got: %v", err)
+	}
+	if err := original.Validate("bad", nil); err != nil {
+		t.Errorf("Expected original without refinement, got: %v", err)
+	}
+	err := original.Validate("ab", nil)
+	if err == nil || err.Errors[0].Message != "Too short" {
+		t.Errorf("Expected original custom error, got: %v", err)
+	}
+
+	// The clone has both its inherited and its own constraints
+	err = clone.Validate("ab", nil)
+	if err == nil || err.Errors[0].Message != "Way too short" {
+		t.Errorf("Expected clone custom error, got: %v", err)
+	}
+	if err := clone.Validate("abcdefgh", nil); err == nil {
+		t.Error("Expected clone to apply Max")
+	}
+	if err := clone.Validate("bad", nil); err == nil {
+		t.Error("Expected clone to apply refinement")
+	}
+}
+
+func TestClone_SharedRefinementBacking(t *testing.T) {
+	// Appending to a clone must not write into spare capacity shared with the original
+	base := Int()
+	base.Refine(func(any) (bool, string) { return true, "" })
+	base.Refine(func(any) (bool, string) { return true, "" })
+	base.Refine(func(any) (bool, string) { return true, "" })
+
+	first := base.Clone().Refine(func(any) (bool, string) { return false, "first" })
+	second := base.Clone().Refine(func(any) (bool, string) { return false, "second" })
+
+	if err := first.Validate(1, nil); err == nil || err.Errors[0].Message != "first" {
+		t.Errorf("Expected first refinement, got: %v", err)
+	}
+	if err := second.Validate(1, nil); err == nil || err.Errors[0].Message != "second" {
+		t.Errorf("Expected second refinement, got: %v", err)
+	}
+	if err := base.Validate(1, nil); err != nil {
+		t.Errorf("Expected base to be unchanged, got: %v", err)
+	}
+}
+
+func TestClone_Nested(t *testing.T) {
+	name := String().Min(2)
+	original := Map(map[string]Schema{
+		"name": name,
+		"tags": Array(String()).Includes("go"),
+	})
+
+	clone := original.Clone()
+	clone.shape["name"].(*StringSchema).Max(3)
+	clone.shape["tags"].(*ArraySchema).Includes("zod")
+
+	value := map[string]any{"name": "Alice", "tags": []any{"go"}}
+	if err := original.Validate(value, nil); err != nil {
+		t.Errorf("Expected original to be unchanged, got: %v", err)
+	}
+	if err := clone.Validate(value, nil); err == nil || len(err.Errors) != 2 {
+		t.Errorf("Expected two errors from the clone, got: %v", err)
+	}
+
+	// CloneSchema works through the Schema interface
+	cloned := CloneSchema(original)
+	if cloned == Schema(original) {
+		t.Error("Expected a new schema")
+	}
+	if err := cloned.Validate(value, nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+}
@@ -0,0 +1,69 @@
+package gozod
+
+import (
+	"sync"
+	"testing"
+)
+
+// TestSchema_ConcurrentValidate validates one shared schema from many goroutines
+// Run with -race to check that validation never writes to schema state
+func TestSchema_ConcurrentValidate(t *testing.T) {
+	type Address struct {
+		City string `json:"city" gozod:"min=2"`
+	}
+	type Person struct {
+		Name    string   `json:"name"`
+		Tags    []string `json:"tags"`
+		Address Address  `json:"address"`
+	}
+
+	var node Schema
+	node = Map(map[string]Schema{
+		"value":    Int(),
+		"children": Array(Lazy(func() Schema { return node })).Nilable(),
+	})
+
+	schema := Map(map[string]Schema{
+		"person": Struct(Shape{
+			"name":    String().Min(2).Email(),
+			"tags":    Array(String()).Unique(),
+			"address": Struct(Shape{}).MergeTags(),
+		}),
+		"id":   Union(Int(), String().Hex()),
+		"tree": node,
+	}).SuperRefine(func(value any, ctx *SuperRefineContext) {
+		if ctx.HasErrorsAt([]any{"person"}) {
+			ctx.AddIssue([]any{"id"}, ErrCodeCustomValidation, "Person is invalid")
+		}
+	})
+
+	valid := map[string]any{
+		"person": Person{Name: "ann@example.com", Tags: []string{"a", "b"}, Address: Address{City: "Oslo"}},
+		"id":     "ff",
+		"tree":   map[string]any{"value": 1, "children": []any{map[string]any{"value": 2}}},
+	}
+	invalid := map[string]any{
+		"person": Person{Name: "a", Tags: []string{"a", "a"}, Address: Address{City: "O"}},
+		"id":     "zz",
+		"tree":   map[string]any{"value": "x"},
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 32; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				if err := schema.Validate(valid, nil); err != nil {
+					t.Errorf("Expected no errors, got: %v", err)
+					return
+				}
+				if err := schema.Validate(invalid, nil); err == nil {
+					t.Error("Expected errors for invalid value")
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,76 @@
+package gozod
+
+import (
+	"strconv"
+	"strings"
+)
+
+// cardSeparators removes the spaces and dashes allowed between card number groups
+var cardSeparators = strings.NewReplacer(" ", "", "-", "")
+
+// normalizeCardNumber strips separators and reports whether the rest is 13-19 digits
+func normalizeCardNumber(str string) (string, bool) {
+	digits := cardSeparators.Replace(str)
+	if len(digits) < 13 || len(digits) > 19 {
+		return digits, false
+	}
+	for i := 0; i < len(digits); i++ {
+		if digits[i] < '0' || digits[i] > '9' {
+			return digits, false
+		}
+	}
+	return digits, true
+}
+
+// luhnValid reports whether digits passes the Luhn checksum
+func luhnValid(digits string) bool {
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// CardBrand returns the card brand for a card number based on its prefix
+// Spaces and dashes are ignored; it returns "" when the brand is not recognized
+// Known brands are "visa", "mastercard", "amex", "discover", "diners", "jcb" and "unionpay"
+func CardBrand(number string) string {
+	digits := cardSeparators.Replace(number)
+	prefix := func(n int) int {
+		if len(digits) < n {
+			return -1
+		}
+		p, err := strconv.Atoi(digits[:n])
+		if err != nil {
+			return -1
+		}
+		return p
+	}
+
+	switch p2, p3, p4 := prefix(2), prefix(3), prefix(4); {
+	case strings.HasPrefix(digits, "4"):
+		return "visa"
+	case p2 >= 51 && p2 <= 55, p4 >= 2221 && p4 <= 2720:
+		return "mastercard"
+	case p2 == 34 || p2 == 37:
+		return "amex"
+	case p4 == 6011, p2 == 65, p3 >= 644 && p3 <= 649:
+		return "discover"
+	case p2 == 36 || p2 == 38 || p2 == 39, p3 >= 300 && p3 <= 305:
+		return "diners"
+	case p4 >= 3528 && p4 <= 3589:
+		return "jcb"
+	case p2 == 62:
+		return "unionpay"
+	}
+	return ""
+}
@@ -0,0 +1,221 @@
+package gozod
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// DateSchema validates time.Time values
+type DateSchema struct {
+	BaseSchema
+	min            *time.Time
+	max            *time.Time
+	allowZeroAsNil bool
+}
+
+// Date creates a new date schema
+func Date() *DateSchema {
+	return &DateSchema{
+		BaseSchema: BaseSchema{required: true},
+	}
+}
+
+// Nilable allows null values
+func (s *DateSchema) Nilable() *DateSchema {
+	s.nilable = true
+	return s
+}
+
+// Optional allows missing values without declaring null a valid value
+// Validation accepts nil as with Nilable; the difference shows in IsOptional and IsNilable
+func (s *DateSchema) Optional() *DateSchema {
+	s.required = false
+	return s
+}
+
+// Min sets the earliest allowed time (inclusive)
+func (s *DateSchema) Min(value time.Time) *DateSchema {
+	s.min = &value
+	return s
+}
+
+// Max sets the latest allowed time (inclusive)
+func (s *DateSchema) Max(value time.Time) *DateSchema {
+	s.max = &value
+	return s
+}
+
+// AllowZeroAsNil treats the zero time.Time (0001-01-01T00:00:00Z) as nil
+// The zero value then fails a required schema and passes a nilable one,
+// which matches how "unset" timestamps are usually stored in database structs
+func (s *DateSchema) AllowZeroAsNil() *DateSchema {
+	s.allowZeroAsNil = true
+	return s
+}
+
+// Refine adds a custom validation function
+// The function receives the value and returns (isValid, errorMessage)
+// If isValid is false, validation fails with the provided errorMessage
+// If errorMessage is empty, a default message will be used
+// Options such as OnlyIfValid and AbortOnFailure control when it runs
+func (s *DateSchema) Refine(validator RefineFunc, opts ...RefineOption) *DateSchema {
+	s.BaseSchema.addRefinement(validator, opts...)
+	return s
+}
+
+// UseRefinement attaches a refinement registered with RegisterRefinement
+// It panics if no refinement is registered under name
+func (s *DateSchema) UseRefinement(name string) *DateSchema {
+	s.BaseSchema.useRefinement(name)
+	return s
+}
+
+// SuperRefine adds a super refinement validation function
+// Similar to Refine, but provides a context object for adding errors with custom paths and codes
+// This allows for more fine-grained control over error reporting, similar to Zod's superRefine
+func (s *DateSchema) SuperRefine(validator SuperRefineFunc) *DateSchema {
+	s.BaseSchema.addSuperRefinement(validator)
+	return s
+}
+
+// AsyncRefine adds a context-aware validation function
+// The function receives the context passed to ValidateCtx and is awaited before validation returns
+// Use it for expensive checks (e.g. database lookups) that need cancellation or deadlines
+func (s *DateSchema) AsyncRefine(validator AsyncRefineFunc) *DateSchema {
+	s.BaseSchema.addAsyncRefinement(validator)
+	return s
+}
+
+// RefineWithParent adds a validation function that also receives the enclosing object
+// It runs when the schema validates a field of a Map or Struct and the field itself is valid,
+// including a nil value on a Nilable field, which makes it suitable for conditionally required fields
+func (s *DateSchema) RefineWithParent(validator ParentRefineFunc) *DateSchema {
+	s.BaseSchema.addParentRefinement(validator)
+	return s
+}
+
+// Or returns a union that accepts values matching either s or other
+func (s *DateSchema) Or(other Schema) *UnionSchema {
+	return Union(s, other)
+}
+
+// And returns an intersection that requires values to match both s and other
+func (s *DateSchema) And(other Schema) *IntersectionSchema {
+	return Intersection(s, other)
+}
+
+// Validate validates a value against the date schema
+func (s *DateSchema) Validate(value any, path []any) *ValidationErrors {
+	return s.ValidateCtx(context.Background(), value, path)
+}
+
+// ValidateCtx validates a value using ctx for cancellation and request scoping
+func (s *DateSchema) ValidateCtx(ctx context.Context, value any, path []any) *ValidationErrors {
+	// Errors are collected in a local value and only escape to the heap when validation fails
+	var errors ValidationErrors
+
+	// Accept time.Time and non-nil *time.Time
+	var t time.Time
+	switch v := value.(type) {
+	case time.Time:
+		t = v
+	case *time.Time:
+		if v == nil {
+			value = nil
+		} else {
+			t = *v
+		}
+	case nil:
+	default:
+		msg := s.getErrorMessage(path, ErrCodeInvalidType, fmt.Sprintf("Expected date, got %T", value))
+		errors.Add(path, ErrCodeInvalidType, msg)
+		return errors.orNil()
+	}
+
+	if value != nil && s.allowZeroAsNil && t.IsZero() {
+		value = nil
+	}
+
+	// Handle nil/nilable
+	if value == nil {
+		if !s.allowsNil() {
+			msg := s.getErrorMessage(path, ErrCodeRequired, "Required")
+			errors.Add(path, ErrCodeRequired, msg)
+		}
+		return errors.orNil()
+	}
+
+	// Min validation
+	// Meta holds the dates in RFC 3339 form, as in the default messages
+	if s.min != nil && t.Before(*s.min) {
+		meta := map[string]any{"minimum": s.min.Format(time.RFC3339), "inclusive": true, "actual": t.Format(time.RFC3339)}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeTooSmall, fmt.Sprintf("Date must be on or after %s, got %s", meta["minimum"], meta["actual"]), meta)
+		errors.AddWithMeta(path, ErrCodeTooSmall, msg, meta)
+	}
+
+	// Max validation
+	if s.max != nil && t.After(*s.max) {
+		meta := map[string]any{"maximum": s.max.Format(time.RFC3339), "inclusive": true, "actual": t.Format(time.RFC3339)}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeTooBig, fmt.Sprintf("Date must be on or before %s, got %s", meta["maximum"], meta["actual"]), meta)
+		errors.AddWithMeta(path, ErrCodeTooBig, msg, meta)
+	}
+
+	// Apply custom refinements (only if type check passed)
+	s.applyRefinements(value, path, &errors)
+
+	// Apply async refinements (only if type check passed)
+	s.applyAsyncRefinements(ctx, value, path, &errors)
+
+	// Apply super refinements (only if type check passed)
+	s.applySuperRefinements(ctx, value, path, &errors)
+
+	return errors.orNil()
+}
+
+// Title sets a short human-readable title used in generated documentation
+func (s *DateSchema) Title(title string) *DateSchema {
+	s.annotations.Title = title
+	return s
+}
+
+// Describe sets a description used in generated documentation
+// It does not affect validation
+func (s *DateSchema) Describe(description string) *DateSchema {
+	s.annotations.Description = description
+	return s
+}
+
+// Example adds an example value used in generated documentation
+func (s *DateSchema) Example(example any) *DateSchema {
+	s.annotations.Examples = append(s.annotations.Examples, example)
+	return s
+}
+
+// Clone returns an independent copy of the schema
+// Constraints, refinements and custom errors are copied, so changing the clone does not affect s
+func (s *DateSchema) Clone() *DateSchema {
+	c := *s
+	c.BaseSchema = s.BaseSchema.clone()
+	return &c
+}
+
+// CustomError sets a custom error message for a specific error code
+func (s *DateSchema) CustomError(code, message string) *DateSchema {
+	if s.BaseSchema.customErrors == nil {
+		s.BaseSchema.customErrors = make(map[string]string)
+	}
+	s.BaseSchema.customErrors[code] = message
+	return s
+}
+
+// SetErrorFormatter sets a custom error formatter function
+func (s *DateSchema) SetErrorFormatter(formatter CustomErrorFunc) *DateSchema {
+	s.BaseSchema.errorFormatter = formatter
+	return s
+}
+
+// Type returns the schema type
+func (s *DateSchema) Type() string {
+	return "date"
+}
@@ -0,0 +1,81 @@
+package gozod
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDateSchema_Required(t *testing.T) {
+	schema := Date()
+
+	if err := schema.Validate(time.Now(), nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+
+	err := schema.Validate(nil, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeRequired {
+		t.Errorf("Expected %s error, got: %v", ErrCodeRequired, err)
+	}
+}
+
+func TestDateSchema_InvalidType(t *testing.T) {
+	err := Date().Validate("2024-01-15", nil)
+	if err == nil || err.Errors[0].Code != ErrCodeInvalidType {
+		t.Errorf("Expected %s error, got: %v", ErrCodeInvalidType, err)
+	}
+}
+
+func TestDateSchema_MinMax(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+	schema := Date().Min(start).Max(end)
+
+	if err := schema.Validate(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+	if err := schema.Validate(start, nil); err != nil {
+		t.Errorf("Expected no errors for inclusive min, got: %v", err)
+	}
+
+	err := schema.Validate(time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC), nil)
+	if err == nil || err.Errors[0].Code != ErrCodeTooSmall {
+		t.Errorf("Expected %s error, got: %v", ErrCodeTooSmall, err)
+	}
+	err = schema.Validate(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), nil)
+	if err == nil || err.Errors[0].Code != ErrCodeTooBig {
+		t.Errorf("Expected %s error, got: %v", ErrCodeTooBig, err)
+	}
+}
+
+func TestDateSchema_AllowZeroAsNil(t *testing.T) {
+	type record struct {
+		DeletedAt time.Time `json:"deletedAt"`
+	}
+
+	// Without the option, the zero value is an ordinary time
+	if err := Struct(Shape{"deletedAt": Date()}).Validate(record{}, nil); err != nil {
+		t.Errorf("Expected zero time to pass by default, got: %v", err)
+	}
+
+	// Zero value passes a nilable schema
+	nilable := Struct(Shape{"deletedAt": Date().AllowZeroAsNil().Nilable()})
+	if err := nilable.Validate(record{}, nil); err != nil {
+		t.Errorf("Expected no errors for zero time with nilable schema, got: %v", err)
+	}
+
+	// Zero value fails a required schema
+	required := Struct(Shape{"deletedAt": Date().AllowZeroAsNil()})
+	err := required.Validate(record{}, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeRequired {
+		t.Errorf("Expected %s error for zero time, got: %v", ErrCodeRequired, err)
+	}
+	if err := required.Validate(record{DeletedAt: time.Now()}, nil); err != nil {
+		t.Errorf("Expected no errors for set time, got: %v", err)
+	}
+}
+
+func TestDateSchema_Type(t *testing.T) {
+	if Date().Type() != "date" {
+		t.Errorf("Expected type 'date', got '%s'", Date().Type())
+	}
+}
@@ -0,0 +1,23 @@
+package gozod
+
+// ValidateDetailed validates value against s and splits the errors by origin
+// Structural errors describe a wrong shape (required, invalid_type, unrecognized_keys, invalid_union);
+// semantic errors describe a well-typed value that breaks a constraint or refinement
+// Either result is nil when it holds no errors; warnings are left out, as with Check
+// The schema comes first, as in Parse, Check and ValidateWithOptions
+func ValidateDetailed(s Schema, value any) (structural, semantic *ValidationErrors) {
+	errs := s.Validate(value, nil).WithoutWarnings()
+	if errs == nil {
+		return nil, nil
+	}
+
+	var structuralErrs, semanticErrs ValidationErrors
+	for _, err := range errs.Errors {
+		if err.IsStructural() {
+			structuralErrs.Errors = append(structuralErrs.Errors, err)
+		} else {
+			semanticErrs.Errors = append(semanticErrs.Errors, err)
+		}
+	}
+	return structuralErrs.orNil(), semanticErrs.orNil()
+}
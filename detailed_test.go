@@ -0,0 +1,53 @@
+package gozod
+
+import "testing"
+
+func TestValidateDetailed(t *testing.T) {
+	schema := Map(map[string]Schema{
+		"name": String().Min(3),
+		"age":  Int(),
+	})
+
+	structural, semantic := ValidateDetailed(schema, map[string]any{
+		"name": "Al",
+		"age":  "thirty",
+	})
+	if structural == nil || len(structural.Errors) != 1 {
+		t.Fatalf("Expected one structural error, got: %v", structural)
+	}
+	if structural.Errors[0].Code != ErrCodeInvalidType || !PathEqual(structural.Errors[0].Path, []any{"age"}) {
+		t.Errorf("Expected invalid_type at age, got: %+v", structural.Errors[0])
+	}
+	if semantic == nil || len(semantic.Errors) != 1 {
+		t.Fatalf("Expected one semantic error, got: %v", semantic)
+	}
+	if semantic.Errors[0].Code != ErrCodeTooSmall || !PathEqual(semantic.Errors[0].Path, []any{"name"}) {
+		t.Errorf("Expected too_small at name, got: %+v", semantic.Errors[0])
+	}
+}
+
+func TestValidateDetailed_Valid(t *testing.T) {
+	structural, semantic := ValidateDetailed(Int().Min(1), 5)
+	if structural != nil || semantic != nil {
+		t.Errorf("Expected no errors, got: %v, %v", structural, semantic)
+	}
+
+	structural, semantic = ValidateDetailed(Int().MultipleOf(5), 7)
+	if structural != nil || semantic == nil || semantic.Errors[0].Code != ErrCodeNotMultipleOf {
+		t.Errorf("Expected only a semantic not_multiple_of error, got: %v, %v", structural, semantic)
+	}
+}
+
+func TestValidateDetailed_IgnoresWarnings(t *testing.T) {
+	schema := Map(map[string]Schema{"name": String().Min(2)}).StrictWarn()
+
+	structural, semantic := ValidateDetailed(schema, map[string]any{"name": "Ann", "legacy": true})
+	if structural != nil || semantic != nil {
+		t.Errorf("Expected a valid value with warnings only to have no errors, got: %v / %v", structural, semantic)
+	}
+
+	structural, semantic = ValidateDetailed(schema, map[string]any{"name": "A", "legacy": true})
+	if structural != nil || semantic == nil || len(semantic.Errors) != 1 {
+		t.Errorf("Expected only the semantic error, got: %v / %v", structural, semantic)
+	}
+}
@@ -0,0 +1,208 @@
+package gozod
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// DurationSchema validates time.Duration values, such as the fields of decoded config structs
+// Strings like "30s" are not parsed; use String().Duration() for those
+type DurationSchema struct {
+	BaseSchema
+	min *time.Duration
+	max *time.Duration
+}
+
+// Duration creates a new duration schema
+func Duration() *DurationSchema {
+	return &DurationSchema{
+		BaseSchema: BaseSchema{required: true},
+	}
+}
+
+// Nilable allows null values
+func (s *DurationSchema) Nilable() *DurationSchema {
+	s.nilable = true
+	return s
+}
+
+// Optional allows missing values without declaring null a valid value
+// Validation accepts nil as with Nilable; the difference shows in IsOptional and IsNilable
+func (s *DurationSchema) Optional() *DurationSchema {
+	s.required = false
+	return s
+}
+
+// Min sets the shortest allowed duration (inclusive)
+func (s *DurationSchema) Min(value time.Duration) *DurationSchema {
+	s.min = &value
+	return s
+}
+
+// Max sets the longest allowed duration (inclusive)
+func (s *DurationSchema) Max(value time.Duration) *DurationSchema {
+	s.max = &value
+	return s
+}
+
+// Refine adds a custom validation function
+// The function receives the value and returns (isValid, errorMessage)
+// If isValid is false, validation fails with the provided errorMessage
+// If errorMessage is empty, a default message will be used
+// Options such as OnlyIfValid and AbortOnFailure control when it runs
+func (s *DurationSchema) Refine(validator RefineFunc, opts ...RefineOption) *DurationSchema {
+	s.BaseSchema.addRefinement(validator, opts...)
+	return s
+}
+
+// UseRefinement attaches a refinement registered with RegisterRefinement
+// It panics if no refinement is registered under name
+func (s *DurationSchema) UseRefinement(name string) *DurationSchema {
+	s.BaseSchema.useRefinement(name)
+	return s
+}
+
+// SuperRefine adds a super refinement validation function
+// Similar to Refine, but provides a context object for adding errors with custom paths and codes
+// This allows for more fine-grained control over error reporting, similar to Zod's superRefine
+func (s *DurationSchema) SuperRefine(validator SuperRefineFunc) *DurationSchema {
+	s.BaseSchema.addSuperRefinement(validator)
+	return s
+}
+
+// AsyncRefine adds a context-aware validation function
+// The function receives the context passed to ValidateCtx and is awaited before validation returns
+// Use it for expensive checks (e.g. database lookups) that need cancellation or deadlines
+func (s *DurationSchema) AsyncRefine(validator AsyncRefineFunc) *DurationSchema {
+	s.BaseSchema.addAsyncRefinement(validator)
+	return s
+}
+
+// RefineWithParent adds a validation function that also receives the enclosing object
+// It runs when the schema validates a field of a Map or Struct and the field itself is valid,
+// including a nil value on a Nilable field, which makes it suitable for conditionally required fields
+func (s *DurationSchema) RefineWithParent(validator ParentRefineFunc) *DurationSchema {
+	s.BaseSchema.addParentRefinement(validator)
+	return s
+}
+
+// Or returns a union that accepts values matching either s or other
+func (s *DurationSchema) Or(other Schema) *UnionSchema {
+	return Union(s, other)
+}
+
+// And returns an intersection that requires values to match both s and other
+func (s *DurationSchema) And(other Schema) *IntersectionSchema {
+	return Intersection(s, other)
+}
+
+// Validate validates a value against the duration schema
+func (s *DurationSchema) Validate(value any, path []any) *ValidationErrors {
+	return s.ValidateCtx(context.Background(), value, path)
+}
+
+// ValidateCtx validates a value using ctx for cancellation and request scoping
+func (s *DurationSchema) ValidateCtx(ctx context.Context, value any, path []any) *ValidationErrors {
+	// Errors are collected in a local value and only escape to the heap when validation fails
+	var errors ValidationErrors
+
+	// Accept time.Duration and non-nil *time.Duration
+	var d time.Duration
+	switch v := value.(type) {
+	case time.Duration:
+		d = v
+	case *time.Duration:
+		if v == nil {
+			value = nil
+		} else {
+			d = *v
+		}
+	case nil:
+	default:
+		msg := s.getErrorMessage(path, ErrCodeInvalidType, fmt.Sprintf("Expected time.Duration, got %T", value))
+		errors.Add(path, ErrCodeInvalidType, msg)
+		return errors.orNil()
+	}
+
+	// Handle nil/nilable
+	if value == nil {
+		if !s.allowsNil() {
+			msg := s.getErrorMessage(path, ErrCodeRequired, "Required")
+			errors.Add(path, ErrCodeRequired, msg)
+		}
+		return errors.orNil()
+	}
+
+	// Min validation
+	if s.min != nil && d < *s.min {
+		meta := map[string]any{"minimum": *s.min, "actual": d}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeTooSmall, fmt.Sprintf("Duration must be at least %s, got %s", *s.min, d), meta)
+		errors.AddWithMeta(path, ErrCodeTooSmall, msg, meta)
+	}
+
+	// Max validation
+	if s.max != nil && d > *s.max {
+		meta := map[string]any{"maximum": *s.max, "actual": d}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeTooBig, fmt.Sprintf("Duration must be at most %s, got %s", *s.max, d), meta)
+		errors.AddWithMeta(path, ErrCodeTooBig, msg, meta)
+	}
+
+	// Apply custom refinements (only if type check passed)
+	s.applyRefinements(value, path, &errors)
+
+	// Apply async refinements (only if type check passed)
+	s.applyAsyncRefinements(ctx, value, path, &errors)
+
+	// Apply super refinements (only if type check passed)
+	s.applySuperRefinements(ctx, value, path, &errors)
+
+	return errors.orNil()
+}
+
+// Title sets a short human-readable title used in generated documentation
+func (s *DurationSchema) Title(title string) *DurationSchema {
+	s.annotations.Title = title
+	return s
+}
+
+// Describe sets a description used in generated documentation
+// It does not affect validation
+func (s *DurationSchema) Describe(description string) *DurationSchema {
+	s.annotations.Description = description
+	return s
+}
+
+// Example adds an example value used in generated documentation
+func (s *DurationSchema) Example(example any) *DurationSchema {
+	s.annotations.Examples = append(s.annotations.Examples, example)
+	return s
+}
+
+// Clone returns an independent copy of the schema
+// Constraints, refinements and custom errors are copied, so changing the clone does not affect s
+func (s *DurationSchema) Clone() *DurationSchema {
+	c := *s
+	c.BaseSchema = s.BaseSchema.clone()
+	return &c
+}
+
+// CustomError sets a custom error message for a specific error code
+func (s *DurationSchema) CustomError(code, message string) *DurationSchema {
+	if s.BaseSchema.customErrors == nil {
+		s.BaseSchema.customErrors = make(map[string]string)
+	}
+	s.BaseSchema.customErrors[code] = message
+	return s
+}
+
+// SetErrorFormatter sets a custom error formatter function
+func (s *DurationSchema) SetErrorFormatter(formatter CustomErrorFunc) *DurationSchema {
+	s.BaseSchema.errorFormatter = formatter
+	return s
+}
+
+// Type returns the schema type
+func (s *DurationSchema) Type() string {
+	return "duration"
+}
@@ -0,0 +1,77 @@
+package gozod
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDurationSchema_Validate(t *testing.T) {
+	schema := Duration().Min(time.Second).Max(time.Hour)
+
+	timeout := 30 * time.Second
+	for _, value := range []any{time.Minute, &timeout, time.Hour} {
+		if err := schema.Validate(value, nil); err != nil {
+			t.Errorf("Expected no errors for %v, got: %v", value, err)
+		}
+	}
+
+	err := schema.Validate(time.Millisecond, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeTooSmall || err.Errors[0].Message != "Duration must be at least 1s, got 1ms" {
+		t.Errorf("Expected too_small error, got: %v", err)
+	}
+	err = schema.Validate(2*time.Hour, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeTooBig || err.Errors[0].Meta["maximum"] != time.Hour {
+		t.Errorf("Expected too_big error, got: %v", err)
+	}
+
+	// Strings and plain integers are not durations
+	for _, value := range []any{"30s", int64(30)} {
+		err := schema.Validate(value, nil)
+		if err == nil || err.Errors[0].Code != ErrCodeInvalidType {
+			t.Errorf("Expected invalid_type error for %v, got: %v", value, err)
+		}
+	}
+
+	var missing *time.Duration
+	if err := schema.Validate(missing, nil); err == nil || err.Errors[0].Code != ErrCodeRequired {
+		t.Errorf("Expected required error for nil pointer, got: %v", err)
+	}
+	if err := Duration().Nilable().Validate(missing, nil); err != nil {
+		t.Errorf("Expected nilable schema to accept nil, got: %v", err)
+	}
+
+	if schema.Type() != "duration" {
+		t.Errorf("Expected type 'duration', got %s", schema.Type())
+	}
+}
+
+func TestDurationSchema_StructFields(t *testing.T) {
+	type Config struct {
+		Timeout   time.Duration  `json:"timeout"`
+		Retry     *time.Duration `json:"retry"`
+		StartedAt time.Time      `json:"startedAt"`
+		Interval  time.Duration  `json:"interval" gozod:"min=1s,max=1m"`
+	}
+
+	schema := Struct(Shape{
+		"timeout":   Duration().Max(time.Minute),
+		"retry":     Duration().Nilable(),
+		"startedAt": Date().Min(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}).MergeTags()
+
+	valid := Config{Timeout: 10 * time.Second, StartedAt: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), Interval: 5 * time.Second}
+	if err := schema.Validate(valid, nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+
+	invalid := Config{Timeout: time.Hour, StartedAt: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), Interval: time.Hour}
+	err := schema.Validate(invalid, nil)
+	if err == nil || len(err.Errors) != 3 {
+		t.Fatalf("Expected three errors, got: %v", err)
+	}
+	for i, key := range []string{"interval", "startedAt", "timeout"} {
+		if PathToString(err.Errors[i].Path) != key {
+			t.Errorf("Expected error %d at %s, got: %v", i, key, err.Errors[i])
+		}
+	}
+}
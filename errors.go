@@ -3,6 +3,7 @@ package gozod
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 )
 
@@ -31,14 +32,87 @@ const (
 
 	// ErrCodeCustomValidation indicates a custom refine validation failed
 	ErrCodeCustomValidation = "custom_validation"
+
+	// ErrCodeCanceled indicates validation was aborted because the context was canceled or timed out
+	ErrCodeCanceled = "canceled"
+
+	// ErrCodeNotSorted indicates array elements are not in ascending order
+	ErrCodeNotSorted = "not_sorted"
+
+	// ErrCodeNotUnique indicates an array contains duplicate elements
+	ErrCodeNotUnique = "not_unique"
+
+	// ErrCodeMissingElement indicates an array does not contain a required element
+	ErrCodeMissingElement = "missing_element"
+
+	// ErrCodeNotPermutation indicates an array is not a permutation of the required values
+	ErrCodeNotPermutation = "not_permutation"
+
+	// ErrCodeInvalidUnion indicates a value matched none of the schemas in a union
+	ErrCodeInvalidUnion = "invalid_union"
+
+	// ErrCodeInvalidLiteral indicates a value does not equal the expected literal
+	ErrCodeInvalidLiteral = "invalid_literal"
+
+	// ErrCodeNotMultipleOf indicates a number is not a multiple of the required step
+	ErrCodeNotMultipleOf = "not_multiple_of"
+
+	// ErrCodeInvalidJSON indicates the input could not be decoded as JSON
+	ErrCodeInvalidJSON = "invalid_json"
+
+	// ErrCodeNotPositive indicates a number is not positive (> 0)
+	ErrCodeNotPositive = "not_positive"
+
+	// ErrCodeNotNegative indicates a number is not negative (< 0)
+	ErrCodeNotNegative = "not_negative"
+
+	// ErrCodeNotNonNegative indicates a number is negative when it must be non-negative (>= 0)
+	ErrCodeNotNonNegative = "not_nonnegative"
+
+	// ErrCodeNotNonPositive indicates a number is positive when it must be non-positive (<= 0)
+	ErrCodeNotNonPositive = "not_nonpositive"
+
+	// ErrCodeNotSafeInteger indicates an integer is outside the range JavaScript can represent exactly
+	ErrCodeNotSafeInteger = "not_safe_integer"
+
+	// ErrCodeNotFinite indicates a number is NaN or infinite
+	ErrCodeNotFinite = "not_finite"
+
+	// ErrCodeTooDeep indicates arrays and objects are nested deeper than the maximum depth
+	ErrCodeTooDeep = "too_deep"
+
+	// ErrCodeResourceLimit indicates an array or object has more entries than its hard ceiling
+	ErrCodeResourceLimit = "resource_limit"
 )
 
+// Severities of validation errors
+const (
+	// SeverityError marks an issue that makes the value invalid; it is the default
+	SeverityError = "error"
+
+	// SeverityWarning marks an issue that is reported but does not make the value invalid,
+	// e.g. unknown keys of a Map with StrictWarn
+	SeverityWarning = "warning"
+)
+
+// MaxSafeInteger is the largest integer that JavaScript numbers (IEEE 754 doubles) represent exactly, 2^53-1
+const MaxSafeInteger = 1<<53 - 1
+
+// structuralCodes are the error codes that describe a wrong shape rather than a bad value
+var structuralCodes = map[string]bool{
+	ErrCodeRequired:         true,
+	ErrCodeInvalidType:      true,
+	ErrCodeUnrecognizedKeys: true,
+	ErrCodeInvalidUnion:     true,
+}
+
 // ValidationError represents a single validation error
 type ValidationError struct {
-	Path    []any          // Field path as array of path parts (e.g., ["user", "email"] or ["test", 1])
-	Message string         // Human-readable error message
-	Code    string         // Error code (use constants like ErrCodeTooSmall, ErrCodeInvalidType, etc.)
-	Meta    map[string]any // Additional metadata for the error
+	Path     []any          // Field path as array of path parts (e.g., ["user", "email"] or ["test", 1])
+	Message  string         // Human-readable error message
+	Code     string         // Error code (use constants like ErrCodeTooSmall, ErrCodeInvalidType, etc.)
+	Meta     map[string]any // Additional metadata for the error
+	Severity string         // SeverityError or SeverityWarning; an empty value counts as an error
 }
 
 // Error implements the error interface
@@ -46,9 +120,32 @@ func (e *ValidationError) Error() string {
 	return e.Message
 }
 
+// Is reports whether target is a *ValidationError with the same code
+// The target acts as a sentinel: a target path, if set, must match too
+// Example: errors.Is(err, &gozod.ValidationError{Code: gozod.ErrCodeRequired})
+func (e *ValidationError) Is(target error) bool {
+	t, ok := target.(*ValidationError)
+	if !ok || t.Code != e.Code {
+		return false
+	}
+	return t.Path == nil || PathEqual(t.Path, e.Path)
+}
+
+// IsStructural reports whether the error is about the shape of the input
+// (missing fields, wrong types, unknown keys) rather than a constraint on a well-typed value
+func (e *ValidationError) IsStructural() bool {
+	return structuralCodes[e.Code]
+}
+
+// IsWarning reports whether the error is a warning that does not make the value invalid
+func (e *ValidationError) IsWarning() bool {
+	return e.Severity == SeverityWarning
+}
+
 // ValidationErrors is a collection of validation errors
 type ValidationErrors struct {
-	Errors []ValidationError
+	Errors  []ValidationError
+	aborted bool // Set while validating one schema when an AbortOnFailure refinement fails
 }
 
 // Error implements the error interface
@@ -67,6 +164,15 @@ func (e *ValidationErrors) Error() string {
 	return strings.Join(messages, "; ")
 }
 
+// Unwrap returns the individual errors so errors.Is and errors.As can inspect them
+func (e *ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i := range e.Errors {
+		errs[i] = &e.Errors[i]
+	}
+	return errs
+}
+
 // Add adds a new validation error
 func (e *ValidationErrors) Add(path []any, code, message string) {
 	e.AddWithMeta(path, code, message, nil)
@@ -74,15 +180,132 @@ func (e *ValidationErrors) Add(path []any, code, message string) {
 
 // AddWithMeta adds a new validation error with metadata
 func (e *ValidationErrors) AddWithMeta(path []any, code, message string, meta map[string]any) {
+	e.add(path, code, message, meta, SeverityError)
+}
+
+// AddWarning adds a warning: it is reported like an error but does not make the value invalid
+func (e *ValidationErrors) AddWarning(path []any, code, message string, meta map[string]any) {
+	e.add(path, code, message, meta, SeverityWarning)
+}
+
+// add appends an error with the given severity and passes it to the OnError hook
+func (e *ValidationErrors) add(path []any, code, message string, meta map[string]any, severity string) {
 	// Make a copy of the path to avoid mutations
 	pathCopy := make([]any, len(path))
 	copy(pathCopy, path)
 	e.Errors = append(e.Errors, ValidationError{
-		Path:    pathCopy,
-		Code:    code,
-		Message: message,
-		Meta:    meta,
+		Path:     pathCopy,
+		Code:     code,
+		Message:  message,
+		Meta:     meta,
+		Severity: severity,
+	})
+	reportError(e.Errors[len(e.Errors)-1])
+}
+
+// HasErrors reports whether e holds at least one error that is not a warning
+// A result holding only warnings means the value is valid; a nil e holds no errors
+func (e *ValidationErrors) HasErrors() bool {
+	if e == nil {
+		return false
+	}
+	for i := range e.Errors {
+		if !e.Errors[i].IsWarning() {
+			return true
+		}
+	}
+	return false
+}
+
+// Warnings returns the warnings in e, in order
+func (e *ValidationErrors) Warnings() []ValidationError {
+	if e == nil {
+		return nil
+	}
+	var warnings []ValidationError
+	for _, err := range e.Errors {
+		if err.IsWarning() {
+			warnings = append(warnings, err)
+		}
+	}
+	return warnings
+}
+
+// WithoutWarnings returns the errors in e that are not warnings, or nil if there are none
+func (e *ValidationErrors) WithoutWarnings() *ValidationErrors {
+	if !e.HasErrors() {
+		return nil
+	}
+	var errs ValidationErrors
+	for _, err := range e.Errors {
+		if !err.IsWarning() {
+			errs.Errors = append(errs.Errors, err)
+		}
+	}
+	return &errs
+}
+
+// Merge appends the errors of other to e, keeping their paths
+// A nil other is ignored; e itself must not be nil (use MergeErrors to combine possibly nil results)
+func (e *ValidationErrors) Merge(other *ValidationErrors) {
+	if other == nil {
+		return
+	}
+	e.Errors = append(e.Errors, other.Errors...)
+}
+
+// PrefixPath prepends prefix to the path of every error, e.g. to graft the errors of
+// an independently validated sub-document under its parent field
+// It modifies e and returns it for chaining; calling it on nil returns nil
+func (e *ValidationErrors) PrefixPath(prefix ...any) *ValidationErrors {
+	if e == nil || len(prefix) == 0 {
+		return e
+	}
+	for i := range e.Errors {
+		path := make([]any, 0, len(prefix)+len(e.Errors[i].Path))
+		path = append(path, prefix...)
+		e.Errors[i].Path = append(path, e.Errors[i].Path...)
+	}
+	return e
+}
+
+// Sort orders the errors by their PathToString form, then by code, keeping the original order of ties
+// Use it for deterministic output, e.g. in golden tests
+// It modifies e and returns it for chaining; calling it on nil returns nil
+func (e *ValidationErrors) Sort() *ValidationErrors {
+	if e == nil {
+		return e
+	}
+	sort.SliceStable(e.Errors, func(i, j int) bool {
+		pathI, pathJ := PathToString(e.Errors[i].Path), PathToString(e.Errors[j].Path)
+		if pathI != pathJ {
+			return pathI < pathJ
+		}
+		return e.Errors[i].Code < e.Errors[j].Code
 	})
+	return e
+}
+
+// MergeErrors combines several validation results into one, in order
+// Nil results are skipped; it returns nil when none of them hold errors
+func MergeErrors(errs ...*ValidationErrors) *ValidationErrors {
+	var merged ValidationErrors
+	for _, err := range errs {
+		merged.Merge(err)
+	}
+	return merged.orNil()
+}
+
+// orNil returns a heap copy of e, or nil when e holds no errors
+// Schemas collect errors in a stack-allocated value and call orNil on return,
+// so successful validation does not allocate an error object
+func (e *ValidationErrors) orNil() *ValidationErrors {
+	if len(e.Errors) == 0 {
+		return nil
+	}
+	result := *e
+	result.aborted = false
+	return &result
 }
 
 // FormatErrors returns a formatted string of all errors
@@ -147,6 +370,55 @@ func (e *ValidationErrors) GetErrorsByCode(code string) []ValidationError {
 	return result
 }
 
+// HasErrorAt reports whether an error was recorded at path or below it,
+// so HasErrorAt([]any{"user"}) is true when "user.email" failed
+// Warnings are ignored, and a nil e has no errors
+func (e *ValidationErrors) HasErrorAt(path []any) bool {
+	if e == nil {
+		return false
+	}
+	for _, err := range e.Errors {
+		if !err.IsWarning() && len(err.Path) >= len(path) && PathEqual(err.Path[:len(path)], path) {
+			return true
+		}
+	}
+	return false
+}
+
+// CountByCode returns the number of errors for each error code
+// Warnings are not counted, and a nil e yields an empty map
+func (e *ValidationErrors) CountByCode() map[string]int {
+	counts := make(map[string]int)
+	if e == nil {
+		return counts
+	}
+	for _, err := range e.Errors {
+		if !err.IsWarning() {
+			counts[err.Code]++
+		}
+	}
+	return counts
+}
+
+// FirstPerField returns at most one error per distinct path, keeping the first one in order
+// Paths are compared by their PathToString form, so the result suits showing a single message per input; a nil e returns nil
+func (e *ValidationErrors) FirstPerField() []ValidationError {
+	if e == nil {
+		return nil
+	}
+	seen := make(map[string]bool, len(e.Errors))
+	var result []ValidationError
+	for _, err := range e.Errors {
+		key := PathToString(err.Path)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, err)
+	}
+	return result
+}
+
 // FlattenErrorResult represents the flattened error structure
 type FlattenErrorResult struct {
 	FormErrors  []string            `json:"formErrors"`
@@ -157,6 +429,7 @@ type FlattenErrorResult struct {
 // formErrors contains errors without a specific field path (empty path)
 // fieldErrors contains errors grouped by field path
 // Array element errors (e.g., test[0], test[1]) are grouped under the base field name (e.g., test)
+// Base field names never contain indices, so the keys are the same for every PathStyle
 func (e *ValidationErrors) Flatten() FlattenErrorResult {
 	result := FlattenErrorResult{
 		FormErrors:  []string{},
@@ -188,27 +461,116 @@ func (e *ValidationErrors) Flatten() FlattenErrorResult {
 	return result
 }
 
+// FieldErrorsEntry pairs a field name with its error messages
+type FieldErrorsEntry struct {
+	Field    string   `json:"field"`
+	Messages []string `json:"messages"`
+}
+
+// OrderedFlattenErrorResult is the deterministic counterpart of FlattenErrorResult
+// Field errors are a list sorted by field name instead of a map
+type OrderedFlattenErrorResult struct {
+	FormErrors  []string           `json:"formErrors"`
+	FieldErrors []FieldErrorsEntry `json:"fieldErrors"`
+}
+
+// FlattenOrdered returns the same grouping as Flatten with field errors sorted by field name
+// Form errors and the messages of each field keep the order in which the errors were added
+func (e *ValidationErrors) FlattenOrdered() OrderedFlattenErrorResult {
+	flat := e.Flatten()
+
+	fields := make([]string, 0, len(flat.FieldErrors))
+	for field := range flat.FieldErrors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	result := OrderedFlattenErrorResult{
+		FormErrors:  flat.FormErrors,
+		FieldErrors: make([]FieldErrorsEntry, 0, len(fields)),
+	}
+	for _, field := range fields {
+		result.FieldErrors = append(result.FieldErrors, FieldErrorsEntry{
+			Field:    field,
+			Messages: flat.FieldErrors[field],
+		})
+	}
+	return result
+}
+
+// Tree returns the errors as a nested map mirroring the shape of the data, like Zod's format()
+// Every node has an "_errors" []string with the messages for its path; nodes with errors below them
+// also have a "fields" map[string]any of child nodes, keyed by field name or array index (as a string, e.g. "0")
+// Children are kept apart from "_errors", so a field named "_errors" does not clash with the messages
+// A nil e yields a root with no messages
+func (e *ValidationErrors) Tree() map[string]any {
+	root := map[string]any{"_errors": []string{}}
+	if e == nil {
+		return root
+	}
+	for _, err := range e.Errors {
+		node := root
+		for _, part := range err.Path {
+			fields, ok := node["fields"].(map[string]any)
+			if !ok {
+				fields = map[string]any{}
+				node["fields"] = fields
+			}
+			key := fmt.Sprint(part)
+			child, ok := fields[key].(map[string]any)
+			if !ok {
+				child = map[string]any{"_errors": []string{}}
+				fields[key] = child
+			}
+			node = child
+		}
+		node["_errors"] = append(node["_errors"].([]string), err.Message)
+	}
+	return root
+}
+
+// PathStyle selects how array indices are rendered by PathToStringStyle
+type PathStyle int
+
+const (
+	// PathStyleBracket renders indices in brackets, e.g. "tags[0].name"
+	PathStyleBracket PathStyle = iota
+	// PathStyleDot renders indices as dot-separated segments, e.g. "tags.0.name"
+	PathStyleDot
+)
+
 // PathToString converts a path array to a string representation
 // e.g., ["user", "email"] -> "user.email", ["test", 1] -> "test[1]"
 func PathToString(path []any) string {
+	return PathToStringStyle(path, PathStyleBracket)
+}
+
+// PathToStringDot converts a path array to a string with dot-separated indices
+// e.g., ["tags", 0, "name"] -> "tags.0.name"
+func PathToStringDot(path []any) string {
+	return PathToStringStyle(path, PathStyleDot)
+}
+
+// PathToStringStyle converts a path array to a string, rendering array indices in the given style
+func PathToStringStyle(path []any, style PathStyle) string {
 	if len(path) == 0 {
 		return ""
 	}
 	var parts []string
 	for _, part := range path {
-		switch v := part.(type) {
-		case string:
+		if v, ok := part.(string); ok {
 			parts = append(parts, v)
-		case int:
-			parts = append(parts, fmt.Sprintf("[%d]", v))
-		case int64:
-			parts = append(parts, fmt.Sprintf("[%d]", v))
-		default:
-			parts = append(parts, fmt.Sprintf("[%v]", v))
+			continue
+		}
+		index := fmt.Sprint(part)
+		if style == PathStyleDot {
+			parts = append(parts, index)
+		} else {
+			parts = append(parts, "["+index+"]")
 		}
 	}
-	if len(parts) == 0 {
-		return ""
+	if style == PathStyleDot {
+		return strings.Join(parts, ".")
 	}
 	result := parts[0]
 	for i := 1; i < len(parts); i++ {
@@ -1,6 +1,7 @@
 package gozod
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -194,6 +195,68 @@ func TestValidationErrors_GetErrorsByCode(t *testing.T) {
 	}
 }
 
+func TestValidationErrors_FirstPerField(t *testing.T) {
+	errors := &ValidationErrors{}
+
+	errors.Add([]any{"password"}, ErrCodeTooSmall, "Too short")
+	errors.Add([]any{"email"}, ErrCodeInvalidString, "Invalid email")
+	errors.Add([]any{"password"}, ErrCodeInvalidString, "Needs a digit")
+	errors.Add([]any{"items", 0}, ErrCodeRequired, "Required")
+	errors.Add([]any{"items", 0}, ErrCodeInvalidType, "Expected string")
+	errors.Add(nil, ErrCodeCustomValidation, "Form error")
+
+	first := errors.FirstPerField()
+	expected := []string{"Too short", "Invalid email", "Required", "Form error"}
+	if len(first) != len(expected) {
+		t.Fatalf("Expected %d errors, got %v", len(expected), first)
+	}
+	for i, message := range expected {
+		if first[i].Message != message {
+			t.Errorf("Expected error %d to be %q, got %q", i, message, first[i].Message)
+		}
+	}
+
+	// The original errors are unchanged
+	if len(errors.Errors) != 6 {
+		t.Errorf("Expected 6 errors to remain, got %d", len(errors.Errors))
+	}
+
+	var nilErrors *ValidationErrors
+	if first := nilErrors.FirstPerField(); first != nil {
+		t.Errorf("Expected nil for a nil receiver, got %v", first)
+	}
+}
+
+func TestValidationErrors_HasErrorAtAndCountByCode(t *testing.T) {
+	errors := &ValidationErrors{}
+	errors.Add([]any{"user", "email"}, ErrCodeInvalidString, "Invalid email")
+	errors.Add([]any{"user", "name"}, ErrCodeTooSmall, "Too short")
+	errors.Add([]any{"items", 0}, ErrCodeTooSmall, "Too small")
+	errors.AddWarning([]any{"legacy"}, ErrCodeUnrecognizedKeys, "Unrecognized key 'legacy'", nil)
+
+	for _, path := range [][]any{{"user"}, {"user", "email"}, {"items", 0}, {}} {
+		if !errors.HasErrorAt(path) {
+			t.Errorf("Expected an error at %v", path)
+		}
+	}
+	for _, path := range [][]any{{"items", 1}, {"user", "email", "domain"}, {"legacy"}, {"use"}} {
+		if errors.HasErrorAt(path) {
+			t.Errorf("Expected no error at %v", path)
+		}
+	}
+
+	counts := errors.CountByCode()
+	if len(counts) != 2 || counts[ErrCodeTooSmall] != 2 || counts[ErrCodeInvalidString] != 1 {
+		t.Errorf("Unexpected counts: %v", counts)
+	}
+
+	// Both are safe on a nil result, as returned by a successful Validate
+	var none *ValidationErrors
+	if none.HasErrorAt(nil) || len(none.CountByCode()) != 0 {
+		t.Error("Expected no errors on a nil result")
+	}
+}
+
 func TestValidationErrors_Flatten(t *testing.T) {
 	errors := &ValidationErrors{}
 
@@ -221,6 +284,56 @@ func TestValidationErrors_Flatten(t *testing.T) {
 	}
 }
 
+func TestValidationErrors_FlattenOrdered(t *testing.T) {
+	errors := &ValidationErrors{}
+
+	errors.Add([]any{}, ErrCodeRequired, "Form error")
+	errors.Add([]any{"name"}, ErrCodeRequired, "Name required")
+	errors.Add([]any{"email"}, ErrCodeInvalidString, "Invalid email")
+	errors.Add([]any{"tags", 0}, ErrCodeRequired, "Tag 0 required")
+	errors.Add([]any{"tags", 1}, ErrCodeRequired, "Tag 1 required")
+
+	flattened := errors.FlattenOrdered()
+
+	if len(flattened.FormErrors) != 1 || flattened.FormErrors[0] != "Form error" {
+		t.Errorf("Expected [Form error], got %v", flattened.FormErrors)
+	}
+
+	expectedFields := []string{"email", "name", "tags"}
+	if len(flattened.FieldErrors) != len(expectedFields) {
+		t.Fatalf("Expected %d field groups, got %d", len(expectedFields), len(flattened.FieldErrors))
+	}
+	for i, field := range expectedFields {
+		if flattened.FieldErrors[i].Field != field {
+			t.Errorf("Expected field %d to be %s, got %s", i, field, flattened.FieldErrors[i].Field)
+		}
+	}
+	tags := flattened.FieldErrors[2].Messages
+	if len(tags) != 2 || tags[0] != "Tag 0 required" || tags[1] != "Tag 1 required" {
+		t.Errorf("Expected tag messages in order, got %v", tags)
+	}
+}
+
+func TestValidationErrors_FlattenOrdered_StableAcrossRuns(t *testing.T) {
+	schema := Map(map[string]Schema{
+		"a": String(),
+		"b": String(),
+		"c": String(),
+		"d": String(),
+		"e": String(),
+	})
+
+	first := schema.Validate(map[string]any{}, nil).FlattenOrdered()
+	for i := 0; i < 20; i++ {
+		next := schema.Validate(map[string]any{}, nil).FlattenOrdered()
+		for j := range first.FieldErrors {
+			if next.FieldErrors[j].Field != first.FieldErrors[j].Field {
+				t.Fatalf("Run %d: field order changed at %d: %s vs %s", i, j, next.FieldErrors[j].Field, first.FieldErrors[j].Field)
+			}
+		}
+	}
+}
+
 func TestPathToString(t *testing.T) {
 	tests := []struct {
 		path     []any
@@ -245,6 +358,35 @@ func TestPathToString(t *testing.T) {
 	}
 }
 
+func TestPathToStringDot(t *testing.T) {
+	tests := []struct {
+		path     []any
+		expected string
+	}{
+		{[]any{}, ""},
+		{[]any{"user", "email"}, "user.email"},
+		{[]any{"test", 0}, "test.0"},
+		{[]any{"user", "tags", 0, "name"}, "user.tags.0.name"},
+		{[]any{"matrix", 1, 2}, "matrix.1.2"},
+		{[]any{0}, "0"},
+		{[]any{"user", int64(5)}, "user.5"},
+	}
+
+	for _, test := range tests {
+		result := PathToStringDot(test.path)
+		if result != test.expected {
+			t.Errorf("PathToStringDot(%v) = '%s', expected '%s'", test.path, result, test.expected)
+		}
+		if styled := PathToStringStyle(test.path, PathStyleDot); styled != result {
+			t.Errorf("PathToStringStyle(%v, PathStyleDot) = '%s', expected '%s'", test.path, styled, result)
+		}
+	}
+
+	if result := PathToStringStyle([]any{"tags", 0}, PathStyleBracket); result != "tags[0]" {
+		t.Errorf("Expected bracket style 'tags[0]', got '%s'", result)
+	}
+}
+
 func TestPathEqual(t *testing.T) {
 	tests := []struct {
 		path1    []any
@@ -329,3 +471,214 @@ func TestValidationErrors_ComplexPaths(t *testing.T) {
 		t.Errorf("Expected 3 errors for users, got %d", len(flattened.FieldErrors["users"]))
 	}
 }
+
+func TestValidationErrors_ErrorsIsAs(t *testing.T) {
+	schema := Map(map[string]Schema{
+		"email": String().Email(),
+	})
+	errs := schema.Validate(map[string]any{"email": "not-an-email"}, nil)
+	if errs == nil {
+		t.Fatal("Expected validation error")
+	}
+	var err error = errs
+
+	if !errors.Is(err, &ValidationError{Code: ErrCodeInvalidString}) {
+		t.Error("Expected errors.Is to match by code")
+	}
+	if !errors.Is(err, &ValidationError{Code: ErrCodeInvalidString, Path: []any{"email"}}) {
+		t.Error("Expected errors.Is to match by code and path")
+	}
+	if errors.Is(err, &ValidationError{Code: ErrCodeInvalidString, Path: []any{"name"}}) {
+		t.Error("Expected errors.Is not to match a different path")
+	}
+	if errors.Is(err, &ValidationError{Code: ErrCodeRequired}) {
+		t.Error("Expected errors.Is not to match a different code")
+	}
+
+	var first *ValidationError
+	if !errors.As(err, &first) {
+		t.Fatal("Expected errors.As to find a *ValidationError")
+	}
+	if first.Code != ErrCodeInvalidString || !PathEqual(first.Path, []any{"email"}) {
+		t.Errorf("Unexpected error: %+v", first)
+	}
+}
+
+func TestValidationErrors_Merge(t *testing.T) {
+	headers := Map(map[string]Schema{"authorization": String()})
+	body := Map(map[string]Schema{"name": String().Min(2)})
+
+	errs := headers.Validate(map[string]any{}, nil)
+	if errs == nil {
+		t.Fatal("Expected header error")
+	}
+	errs.Merge(body.Validate(map[string]any{"name": "A"}, nil))
+	errs.Merge(nil)
+
+	if len(errs.Errors) != 2 {
+		t.Fatalf("Expected 2 errors, got %d", len(errs.Errors))
+	}
+	if !PathEqual(errs.Errors[0].Path, []any{"authorization"}) || !PathEqual(errs.Errors[1].Path, []any{"name"}) {
+		t.Errorf("Expected paths to be preserved, got: %v", errs.Errors)
+	}
+}
+
+func TestValidationErrors_Sort(t *testing.T) {
+	errors := &ValidationErrors{}
+	errors.Add([]any{"name"}, ErrCodeTooSmall, "Too short")
+	errors.Add([]any{"age"}, ErrCodeTooSmall, "Too young")
+	errors.Add([]any{"name"}, ErrCodeInvalidString, "Invalid name")
+	errors.Add([]any{"address", "zip"}, ErrCodeRequired, "Zip required")
+	errors.Add([]any{"name"}, ErrCodeInvalidString, "Second invalid name")
+
+	if errors.Sort() != errors {
+		t.Error("Expected Sort to return its receiver")
+	}
+	expected := []string{"Zip required", "Too young", "Invalid name", "Second invalid name", "Too short"}
+	for i, message := range expected {
+		if errors.Errors[i].Message != message {
+			t.Errorf("Expected error %d to be %q, got %q", i, message, errors.Errors[i].Message)
+		}
+	}
+
+	// Map field errors come out in the same order on every run
+	schema := Map(map[string]Schema{"a": String(), "b": String(), "c": String(), "d": String()})
+	first := schema.Validate(map[string]any{}, nil).Sort().FormatErrors()
+	for range 20 {
+		if got := schema.Validate(map[string]any{}, nil).Sort().FormatErrors(); got != first {
+			t.Fatalf("Expected stable output, got %q and %q", first, got)
+		}
+	}
+
+	var nilErrors *ValidationErrors
+	if nilErrors.Sort() != nil {
+		t.Error("Expected nil for a nil receiver")
+	}
+}
+
+func TestMergeErrors(t *testing.T) {
+	if MergeErrors() != nil || MergeErrors(nil, nil) != nil {
+		t.Error("Expected nil when there are no errors")
+	}
+
+	first := &ValidationErrors{}
+	first.Add([]any{"query", "page"}, ErrCodeInvalidType, "Expected integer")
+	second := &ValidationErrors{}
+	second.Add([]any{"body", "email"}, ErrCodeInvalidString, "Invalid email")
+
+	merged := MergeErrors(first, nil, second)
+	if merged == nil || len(merged.Errors) != 2 {
+		t.Fatalf("Expected 2 errors, got: %v", merged)
+	}
+	if merged.Errors[0].Code != ErrCodeInvalidType || merged.Errors[1].Code != ErrCodeInvalidString {
+		t.Errorf("Expected errors in order, got: %v", merged.Errors)
+	}
+	if len(first.Errors) != 1 {
+		t.Error("Expected inputs not to be modified")
+	}
+}
+
+func TestValidationErrors_PrefixPath(t *testing.T) {
+	body := Map(map[string]Schema{
+		"items": Array(Int()),
+	})
+
+	errs := body.Validate(map[string]any{"items": []any{1, "two"}}, nil).PrefixPath("body")
+	if errs == nil {
+		t.Fatal("Expected error")
+	}
+	if !PathEqual(errs.Errors[0].Path, []any{"body", "items", 1}) {
+		t.Errorf("Expected path [body items 1], got %v", errs.Errors[0].Path)
+	}
+
+	// Nil results pass through, so PrefixPath chains into MergeErrors
+	var none *ValidationErrors
+	if none.PrefixPath("query") != nil {
+		t.Error("Expected nil for nil receiver")
+	}
+	merged := MergeErrors(
+		String().Validate(nil, nil).PrefixPath("headers", "authorization"),
+		Int().Validate(1, nil).PrefixPath("query"),
+	)
+	if merged == nil || len(merged.Errors) != 1 || !PathEqual(merged.Errors[0].Path, []any{"headers", "authorization"}) {
+		t.Errorf("Unexpected merged errors: %v", merged)
+	}
+}
+
+func TestValidationErrors_Tree(t *testing.T) {
+	schema := Map(map[string]Schema{
+		"user": Map(map[string]Schema{
+			"email": String().Email(),
+		}),
+		"items": Array(Map(map[string]Schema{
+			"qty": Int().Min(1),
+		})),
+	}).Refine(func(value any) (bool, string) {
+		return false, "Order is invalid"
+	})
+
+	errs := schema.Validate(map[string]any{
+		"user":  map[string]any{"email": "nope"},
+		"items": []any{map[string]any{"qty": 1}, map[string]any{"qty": 0}},
+	}, nil)
+	if errs == nil {
+		t.Fatal("Expected errors")
+	}
+
+	tree := errs.Tree()
+	if root := tree["_errors"].([]string); len(root) != 1 || root[0] != "Order is invalid" {
+		t.Errorf("Expected root error, got: %v", root)
+	}
+
+	// child returns the node for key under node's fields
+	child := func(node map[string]any, key string) map[string]any {
+		t.Helper()
+		fields, _ := node["fields"].(map[string]any)
+		c, ok := fields[key].(map[string]any)
+		if !ok {
+			t.Fatalf("Expected a node for %q, got: %v", key, node)
+		}
+		return c
+	}
+
+	user := child(tree, "user")
+	if len(user["_errors"].([]string)) != 0 {
+		t.Errorf("Expected no errors on user itself, got: %v", user["_errors"])
+	}
+	email := child(user, "email")
+	if len(email["_errors"].([]string)) != 1 {
+		t.Errorf("Expected one email error, got: %v", email["_errors"])
+	}
+	if _, ok := email["fields"]; ok {
+		t.Error("Expected no fields on a leaf node")
+	}
+
+	items := child(tree, "items")
+	if _, ok := items["fields"].(map[string]any)["0"]; ok {
+		t.Error("Expected no node for the valid item")
+	}
+	qty := child(child(items, "1"), "qty")
+	if len(qty["_errors"].([]string)) != 1 {
+		t.Errorf("Expected one qty error, got: %v", qty["_errors"])
+	}
+}
+
+func TestValidationErrors_TreeReservedKey(t *testing.T) {
+	errs := &ValidationErrors{}
+	errs.Add(nil, ErrCodeCustomValidation, "Root error")
+	errs.Add([]any{"_errors"}, ErrCodeRequired, "Field error")
+
+	tree := errs.Tree()
+	if root := tree["_errors"].([]string); len(root) != 1 || root[0] != "Root error" {
+		t.Errorf("Expected the root messages to be kept, got: %v", root)
+	}
+	field := tree["fields"].(map[string]any)["_errors"].(map[string]any)
+	if messages := field["_errors"].([]string); len(messages) != 1 || messages[0] != "Field error" {
+		t.Errorf("Expected the field's own messages, got: %v", messages)
+	}
+
+	var none *ValidationErrors
+	if tree := none.Tree(); len(tree) != 1 || len(tree["_errors"].([]string)) != 0 {
+		t.Errorf("Expected an empty root for a nil result, got: %v", tree)
+	}
+}
@@ -1,7 +1,13 @@
 package gozod
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
 )
 
 // FloatSchema validates float values
@@ -9,11 +15,17 @@ type FloatSchema struct {
 	BaseSchema
 	min         *float64
 	max         *float64
+	gt          *float64 // Exclusive lower bound
+	lt          *float64 // Exclusive upper bound
 	positive    bool
 	negative    bool
 	nonNegative bool
 	nonPositive bool
 	multipleOf  *float64
+	acceptInt   bool // Accept integers and convert them to float64
+	coerce      bool // Convert integers, numeric strings and json.Number values to float64
+	step        *float64
+	finite      bool
 }
 
 // Float creates a new float schema
@@ -29,6 +41,13 @@ func (s *FloatSchema) Nilable() *FloatSchema {
 	return s
 }
 
+// Optional allows missing values for FloatSchema without declaring null a valid value
+// Validation accepts nil as with Nilable; the difference shows in IsOptional and IsNilable
+func (s *FloatSchema) Optional() *FloatSchema {
+	s.required = false
+	return s
+}
+
 // Min sets the minimum value for FloatSchema
 func (s *FloatSchema) Min(value float64) *FloatSchema {
 	s.min = &value
@@ -41,6 +60,29 @@ func (s *FloatSchema) Max(value float64) *FloatSchema {
 	return s
 }
 
+// Between sets the minimum and maximum value at once
+// It panics if min is greater than max
+func (s *FloatSchema) Between(min, max float64) *FloatSchema {
+	if math.IsNaN(min) || math.IsNaN(max) || min > max {
+		panic(fmt.Sprintf("gozod: Between requires min <= max, got %v and %v", min, max))
+	}
+	s.min = &min
+	s.max = &max
+	return s
+}
+
+// GreaterThan sets an exclusive lower bound: the value must be strictly greater than value
+func (s *FloatSchema) GreaterThan(value float64) *FloatSchema {
+	s.gt = &value
+	return s
+}
+
+// LessThan sets an exclusive upper bound: the value must be strictly less than value
+func (s *FloatSchema) LessThan(value float64) *FloatSchema {
+	s.lt = &value
+	return s
+}
+
 // Positive validates that the number is positive (> 0) for FloatSchema
 func (s *FloatSchema) Positive() *FloatSchema {
 	s.positive = true
@@ -65,18 +107,66 @@ func (s *FloatSchema) NonPositive() *FloatSchema {
 	return s
 }
 
+// Finite validates that the number is neither NaN nor infinite
+// JSON cannot represent these values, so use it for data that is serialized to JSON
+func (s *FloatSchema) Finite() *FloatSchema {
+	s.finite = true
+	return s
+}
+
 // MultipleOf validates that the number is a multiple of the given value for FloatSchema
+// A negative value is treated as its absolute value; zero, NaN and infinities panic
 func (s *FloatSchema) MultipleOf(value float64) *FloatSchema {
+	if value == 0 || math.IsNaN(value) || math.IsInf(value, 0) {
+		panic(fmt.Sprintf("gozod: MultipleOf requires a finite non-zero value, got %v", value))
+	}
+	value = math.Abs(value)
 	s.multipleOf = &value
 	return s
 }
 
+// Step validates that the number is Min plus a whole number of steps (min + k*step)
+// Without Min the base is zero, which makes it equivalent to MultipleOf
+// A negative step is treated as its absolute value; zero, NaN and infinities panic
+func (s *FloatSchema) Step(step float64) *FloatSchema {
+	if step == 0 || math.IsNaN(step) || math.IsInf(step, 0) {
+		panic(fmt.Sprintf("gozod: Step requires a finite non-zero value, got %v", step))
+	}
+	step = math.Abs(step)
+	s.step = &step
+	return s
+}
+
+// AcceptInt accepts integer values and converts them to float64
+// The conversion happens before checks and refinements run, and Parse returns the float64
+func (s *FloatSchema) AcceptInt() *FloatSchema {
+	s.acceptInt = true
+	return s
+}
+
+// Coerce converts numeric inputs to float64 before validation: integers of any type (as with AcceptInt),
+// json.Number values and strings holding a finite number (surrounding spaces ignored)
+// It lets Array(Float().Coerce()) validate an []int; other values fail with ErrCodeInvalidType,
+// and Parse returns the float64
+func (s *FloatSchema) Coerce() *FloatSchema {
+	s.coerce = true
+	return s
+}
+
 // Refine adds a custom validation function
 // The function receives the value and returns (isValid, errorMessage)
 // If isValid is false, validation fails with the provided errorMessage
 // If errorMessage is empty, a default message will be used
-func (s *FloatSchema) Refine(validator RefineFunc) *FloatSchema {
-	s.BaseSchema.addRefinement(validator)
+// Options such as OnlyIfValid and AbortOnFailure control when it runs
+func (s *FloatSchema) Refine(validator RefineFunc, opts ...RefineOption) *FloatSchema {
+	s.BaseSchema.addRefinement(validator, opts...)
+	return s
+}
+
+// UseRefinement attaches a refinement registered with RegisterRefinement
+// It panics if no refinement is registered under name
+func (s *FloatSchema) UseRefinement(name string) *FloatSchema {
+	s.BaseSchema.useRefinement(name)
 	return s
 }
 
@@ -88,20 +178,54 @@ func (s *FloatSchema) SuperRefine(validator SuperRefineFunc) *FloatSchema {
 	return s
 }
 
+// AsyncRefine adds a context-aware validation function
+// The function receives the context passed to ValidateCtx and is awaited before validation returns
+// Use it for expensive checks (e.g. database lookups) that need cancellation or deadlines
+func (s *FloatSchema) AsyncRefine(validator AsyncRefineFunc) *FloatSchema {
+	s.BaseSchema.addAsyncRefinement(validator)
+	return s
+}
+
+// RefineWithParent adds a validation function that also receives the enclosing object
+// It runs when the schema validates a field of a Map or Struct and the field itself is valid,
+// including a nil value on a Nilable field, which makes it suitable for conditionally required fields
+func (s *FloatSchema) RefineWithParent(validator ParentRefineFunc) *FloatSchema {
+	s.BaseSchema.addParentRefinement(validator)
+	return s
+}
+
+// Or returns a union that accepts values matching either s or other
+func (s *FloatSchema) Or(other Schema) *UnionSchema {
+	return Union(s, other)
+}
+
+// And returns an intersection that requires values to match both s and other
+func (s *FloatSchema) And(other Schema) *IntersectionSchema {
+	return Intersection(s, other)
+}
+
 // Validate validates a value against the float schema
 func (s *FloatSchema) Validate(value any, path []any) *ValidationErrors {
-	errors := &ValidationErrors{}
+	return s.ValidateCtx(context.Background(), value, path)
+}
+
+// ValidateCtx validates a value using ctx for cancellation and request scoping
+func (s *FloatSchema) ValidateCtx(ctx context.Context, value any, path []any) *ValidationErrors {
+	// Errors are collected in a local value and only escape to the heap when validation fails
+	var errors ValidationErrors
 
 	// Handle nil/nilable
 	if value == nil {
-		if !s.nilable {
+		if !s.allowsNil() {
 			msg := s.getErrorMessage(path, ErrCodeRequired, "Required")
 			errors.Add(path, ErrCodeRequired, msg)
-			return errors
+			return errors.orNil()
 		}
 		return nil
 	}
 
+	value = s.convert(ctx, value)
+
 	// Convert to float64 for validation
 	var num float64
 	var isFloat bool
@@ -111,7 +235,7 @@ func (s *FloatSchema) Validate(value any, path []any) *ValidationErrors {
 		// Reject integers
 		msg := s.getErrorMessage(path, ErrCodeInvalidType, "Expected float, got integer")
 		errors.Add(path, ErrCodeInvalidType, msg)
-		return errors
+		return errors.orNil()
 	case float32:
 		num = float64(v)
 		isFloat = true
@@ -121,71 +245,218 @@ func (s *FloatSchema) Validate(value any, path []any) *ValidationErrors {
 	default:
 		msg := s.getErrorMessage(path, ErrCodeInvalidType, fmt.Sprintf("Expected float, got %T", value))
 		errors.Add(path, ErrCodeInvalidType, msg)
-		return errors
+		return errors.orNil()
 	}
 
 	if !isFloat {
 		msg := s.getErrorMessage(path, ErrCodeInvalidType, "Expected float, got integer")
 		errors.Add(path, ErrCodeInvalidType, msg)
-		return errors
+		return errors.orNil()
+	}
+
+	// Finite validation
+	if s.finite && (math.IsNaN(num) || math.IsInf(num, 0)) {
+		msg := s.getErrorMessage(path, ErrCodeNotFinite, fmt.Sprintf("Number must be finite, got %v", num))
+		errors.Add(path, ErrCodeNotFinite, msg)
 	}
 
 	// Min validation
 	if s.min != nil && num < *s.min {
-		msg := s.getErrorMessage(path, ErrCodeTooSmall, fmt.Sprintf("Number must be greater than or equal to %v, got %v", *s.min, num))
-		errors.Add(path, ErrCodeTooSmall, msg)
+		meta := map[string]any{"minimum": *s.min, "inclusive": true, "actual": num}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeTooSmall, fmt.Sprintf("Number must be greater than or equal to %v, got %v", *s.min, num), meta)
+		errors.AddWithMeta(path, ErrCodeTooSmall, msg, meta)
 	}
 
 	// Max validation
 	if s.max != nil && num > *s.max {
-		msg := s.getErrorMessage(path, ErrCodeTooBig, fmt.Sprintf("Number must be less than or equal to %v, got %v", *s.max, num))
-		errors.Add(path, ErrCodeTooBig, msg)
+		meta := map[string]any{"maximum": *s.max, "inclusive": true, "actual": num}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeTooBig, fmt.Sprintf("Number must be less than or equal to %v, got %v", *s.max, num), meta)
+		errors.AddWithMeta(path, ErrCodeTooBig, msg, meta)
+	}
+
+	// GreaterThan validation
+	if s.gt != nil && num <= *s.gt {
+		meta := map[string]any{"minimum": *s.gt, "inclusive": false, "actual": num}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeTooSmall, fmt.Sprintf("Number must be greater than %v, got %v", *s.gt, num), meta)
+		errors.AddWithMeta(path, ErrCodeTooSmall, msg, meta)
+	}
+
+	// LessThan validation
+	if s.lt != nil && num >= *s.lt {
+		meta := map[string]any{"maximum": *s.lt, "inclusive": false, "actual": num}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeTooBig, fmt.Sprintf("Number must be less than %v, got %v", *s.lt, num), meta)
+		errors.AddWithMeta(path, ErrCodeTooBig, msg, meta)
 	}
 
 	// Positive validation
 	if s.positive && num <= 0 {
-		msg := s.getErrorMessage(path, ErrCodeTooSmall, fmt.Sprintf("Number must be positive (> 0), got %v", num))
-		errors.Add(path, ErrCodeTooSmall, msg)
+		meta := map[string]any{"constraint": "positive", "minimum": 0.0, "inclusive": false, "actual": num}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeNotPositive, fmt.Sprintf("Number must be positive (> 0), got %v", num), meta)
+		errors.AddWithMeta(path, ErrCodeNotPositive, msg, meta)
 	}
 
 	// Negative validation
 	if s.negative && num >= 0 {
-		msg := s.getErrorMessage(path, ErrCodeTooBig, fmt.Sprintf("Number must be negative (< 0), got %v", num))
-		errors.Add(path, ErrCodeTooBig, msg)
+		meta := map[string]any{"constraint": "negative", "maximum": 0.0, "inclusive": false, "actual": num}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeNotNegative, fmt.Sprintf("Number must be negative (< 0), got %v", num), meta)
+		errors.AddWithMeta(path, ErrCodeNotNegative, msg, meta)
 	}
 
 	// NonNegative validation
 	if s.nonNegative && num < 0 {
-		msg := s.getErrorMessage(path, ErrCodeTooSmall, fmt.Sprintf("Number must be non-negative (>= 0), got %v", num))
-		errors.Add(path, ErrCodeTooSmall, msg)
+		meta := map[string]any{"constraint": "nonNegative", "minimum": 0.0, "inclusive": true, "actual": num}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeNotNonNegative, fmt.Sprintf("Number must be non-negative (>= 0), got %v", num), meta)
+		errors.AddWithMeta(path, ErrCodeNotNonNegative, msg, meta)
 	}
 
 	// NonPositive validation
 	if s.nonPositive && num > 0 {
-		msg := s.getErrorMessage(path, ErrCodeTooBig, fmt.Sprintf("Number must be non-positive (<= 0), got %v", num))
-		errors.Add(path, ErrCodeTooBig, msg)
+		meta := map[string]any{"constraint": "nonPositive", "maximum": 0.0, "inclusive": true, "actual": num}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeNotNonPositive, fmt.Sprintf("Number must be non-positive (<= 0), got %v", num), meta)
+		errors.AddWithMeta(path, ErrCodeNotNonPositive, msg, meta)
 	}
 
 	// MultipleOf validation
 	if s.multipleOf != nil {
-		remainder := num / *s.multipleOf
-		// Check if remainder is close to an integer (handling floating point precision)
-		if remainder-float64(int64(remainder)) > 0.0001 && remainder-float64(int64(remainder)) < 0.9999 {
-			msg := s.getErrorMessage(path, ErrCodeInvalidType, fmt.Sprintf("Number must be a multiple of %v, got %v", *s.multipleOf, num))
-			errors.Add(path, ErrCodeInvalidType, msg)
+		if !isNearInteger(num / *s.multipleOf) {
+			msg := s.getErrorMessage(path, ErrCodeNotMultipleOf, fmt.Sprintf("Number must be a multiple of %v, got %v", *s.multipleOf, num))
+			errors.Add(path, ErrCodeNotMultipleOf, msg)
+		}
+	}
+
+	// Step validation
+	if s.step != nil {
+		base := 0.0
+		if s.min != nil {
+			base = *s.min
+		}
+		if !isNearInteger((num - base) / *s.step) {
+			meta := map[string]any{"step": *s.step, "base": base}
+			msg := s.getErrorMessageWithMeta(path, ErrCodeNotMultipleOf, fmt.Sprintf("Number must be %v plus a multiple of %v, got %v", base, *s.step, num), meta)
+			errors.AddWithMeta(path, ErrCodeNotMultipleOf, msg, meta)
 		}
 	}
 
 	// Apply custom refinements (only if type check passed)
-	s.applyRefinements(value, path, errors)
+	s.applyRefinements(value, path, &errors)
+
+	// Apply async refinements (only if type check passed)
+	s.applyAsyncRefinements(ctx, value, path, &errors)
 
 	// Apply super refinements (only if type check passed)
-	s.applySuperRefinements(value, path, errors)
+	s.applySuperRefinements(ctx, value, path, &errors)
 
-	if len(errors.Errors) == 0 {
-		return nil
+	return errors.orNil()
+}
+
+// isNearInteger reports whether q is within floating point tolerance of a whole number
+func isNearInteger(q float64) bool {
+	q = math.Abs(q)
+	fraction := q - math.Floor(q)
+	return fraction <= 0.0001 || fraction >= 0.9999
+}
+
+// parse validates value and returns it, converted to float64 if AcceptInt or Coerce accepted it
+// or it is a whole number decoded by ParseJSON
+func (s *FloatSchema) parse(ctx context.Context, value any, path []any) (any, *ValidationErrors) {
+	value = s.convert(ctx, value)
+	if errs := s.ValidateCtx(ctx, value, path); errs != nil {
+		return nil, errs
+	}
+	return value, nil
+}
+
+// convert applies the conversions enabled by Coerce and AcceptInt to value
+// Integers decoded by ParseJSON are converted as with AcceptInt
+func (s *FloatSchema) convert(ctx context.Context, value any) any {
+	switch {
+	case s.coerce:
+		return coerceFloat(value)
+	case s.acceptInt || isJSONInput(ctx):
+		if n, ok := value.(json.Number); ok {
+			return coerceFloat(n)
+		}
+		return intToFloat(value)
+	}
+	return value
+}
+
+// coerceFloat converts integers, json.Number values and numeric strings to float64
+// Unrecognized values, and strings holding NaN or an infinity, are returned unchanged so the type check reports them
+func coerceFloat(value any) any {
+	switch v := value.(type) {
+	case float64, float32, nil:
+		return value
+	case json.Number:
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+		return value
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+			return value
+		}
+		return f
+	}
+	if rv := reflect.ValueOf(value); numericKind(rv.Kind()) == kindSigned || numericKind(rv.Kind()) == kindUnsigned {
+		return toFloat64(rv)
 	}
-	return errors
+	return value
+}
+
+// intToFloat converts integers to float64, returning other values unchanged
+func intToFloat(value any) any {
+	switch v := value.(type) {
+	case int:
+		return float64(v)
+	case int8:
+		return float64(v)
+	case int16:
+		return float64(v)
+	case int32:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case uint:
+		return float64(v)
+	case uint8:
+		return float64(v)
+	case uint16:
+		return float64(v)
+	case uint32:
+		return float64(v)
+	case uint64:
+		return float64(v)
+	}
+	return value
+}
+
+// Title sets a short human-readable title used in generated documentation
+func (s *FloatSchema) Title(title string) *FloatSchema {
+	s.annotations.Title = title
+	return s
+}
+
+// Describe sets a description used in generated documentation
+// It does not affect validation
+func (s *FloatSchema) Describe(description string) *FloatSchema {
+	s.annotations.Description = description
+	return s
+}
+
+// Example adds an example value used in generated documentation
+func (s *FloatSchema) Example(example any) *FloatSchema {
+	s.annotations.Examples = append(s.annotations.Examples, example)
+	return s
+}
+
+// Clone returns an independent copy of the schema
+// Constraints, refinements and custom errors are copied, so changing the clone does not affect s
+func (s *FloatSchema) Clone() *FloatSchema {
+	c := *s
+	c.BaseSchema = s.BaseSchema.clone()
+	return &c
 }
 
 // CustomError sets a custom error message for a specific error code for FloatSchema
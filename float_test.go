@@ -1,6 +1,9 @@
 package gozod
 
 import (
+	"encoding/json"
+	"math"
+	"strings"
 	"testing"
 )
 
@@ -334,3 +337,182 @@ func TestFloatSchema_ZeroValue(t *testing.T) {
 		t.Error("Expected error for zero with Positive()")
 	}
 }
+
+func TestFloatSchema_AcceptInt(t *testing.T) {
+	schema := Float().AcceptInt()
+
+	if err := schema.Validate(42, nil); err != nil {
+		t.Errorf("Expected no errors for 42, got: %v", err)
+	}
+
+	// Parse returns the float64
+	f, errs := Parse[float64](schema, 42)
+	if errs != nil || f != 42.0 {
+		t.Errorf("Expected 42.0, got %v (%v)", f, errs)
+	}
+
+	// Integers stay rejected without the option
+	if err := Float().Validate(42, nil); err == nil {
+		t.Error("Expected error for integer without AcceptInt")
+	}
+}
+
+func TestFloatSchema_MultipleOf_Guards(t *testing.T) {
+	schema := Float().MultipleOf(-2.5)
+	if err := schema.Validate(7.5, nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+
+	// Negative inputs
+	if err := Float().MultipleOf(2.5).Validate(-7.5, nil); err != nil {
+		t.Errorf("Expected no errors for -7.5, got: %v", err)
+	}
+	err := Float().MultipleOf(2.5).Validate(-7.0, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeNotMultipleOf {
+		t.Errorf("Expected %s error for -7.0, got: %v", ErrCodeNotMultipleOf, err)
+	}
+
+	for _, step := range []float64{0, math.NaN(), math.Inf(1)} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Expected panic for MultipleOf(%v)", step)
+				}
+			}()
+			Float().MultipleOf(step)
+		}()
+	}
+}
+
+func TestFloatSchema_Step(t *testing.T) {
+	// Slider from 0.5 in steps of 0.25
+	schema := Float().Min(0.5).Step(0.25)
+	for _, value := range []float64{0.5, 0.75, 1.0, 10.25} {
+		if err := schema.Validate(value, nil); err != nil {
+			t.Errorf("Expected no errors for %v, got: %v", value, err)
+		}
+	}
+	err := schema.Validate(0.6, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeNotMultipleOf {
+		t.Errorf("Expected %s error for 0.6, got: %v", ErrCodeNotMultipleOf, err)
+	}
+
+	// Without Min the base is zero
+	if err := Float().Step(0.1).Validate(0.3, nil); err != nil {
+		t.Errorf("Expected no errors for 0.3, got: %v", err)
+	}
+	if err := Float().Step(0.1).Validate(0.35, nil); err == nil {
+		t.Error("Expected error for 0.35")
+	}
+}
+
+func TestFloatSchema_Between(t *testing.T) {
+	schema := Float().Between(0, 1)
+
+	if err := schema.Validate(0.5, nil); err != nil {
+		t.Errorf("Expected no errors for 0.5, got: %v", err)
+	}
+	err := schema.Validate(-0.1, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeTooSmall {
+		t.Errorf("Expected %s error for -0.1, got: %v", ErrCodeTooSmall, err)
+	}
+	err = schema.Validate(1.1, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeTooBig {
+		t.Errorf("Expected %s error for 1.1, got: %v", ErrCodeTooBig, err)
+	}
+
+	for _, bounds := range [][2]float64{{1, 0}, {math.NaN(), 1}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Expected panic for Between(%v, %v)", bounds[0], bounds[1])
+				}
+			}()
+			Float().Between(bounds[0], bounds[1])
+		}()
+	}
+}
+
+func TestFloatSchema_SignCodes(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema *FloatSchema
+		value  float64
+		code   string
+	}{
+		{"Positive", Float().Positive(), 0, ErrCodeNotPositive},
+		{"Negative", Float().Negative(), 0, ErrCodeNotNegative},
+		{"NonNegative", Float().NonNegative(), -0.5, ErrCodeNotNonNegative},
+		{"NonPositive", Float().NonPositive(), 0.5, ErrCodeNotNonPositive},
+	}
+	for _, tt := range tests {
+		err := tt.schema.Validate(tt.value, nil)
+		if err == nil || err.Errors[0].Code != tt.code {
+			t.Errorf("%s: expected %s error, got: %v", tt.name, tt.code, err)
+			continue
+		}
+		// Meta names the rule and the offending value
+		meta := err.Errors[0].Meta
+		constraint := strings.ToLower(tt.name[:1]) + tt.name[1:]
+		if meta["constraint"] != constraint || meta["actual"] != tt.value {
+			t.Errorf("%s: expected constraint %q and actual %v in meta, got %v", tt.name, constraint, tt.value, meta)
+		}
+	}
+}
+
+func TestFloatSchema_ExclusiveBounds(t *testing.T) {
+	// Open interval 0 < x < 1
+	schema := Float().GreaterThan(0).LessThan(1)
+
+	if err := schema.Validate(0.5, nil); err != nil {
+		t.Errorf("Expected no errors for 0.5, got: %v", err)
+	}
+	err := schema.Validate(0.0, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeTooSmall {
+		t.Errorf("Expected %s error for 0, got: %v", ErrCodeTooSmall, err)
+	}
+	err = schema.Validate(1.0, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeTooBig {
+		t.Errorf("Expected %s error for 1, got: %v", ErrCodeTooBig, err)
+	}
+	if err.Errors[0].Message != "Number must be less than 1, got 1" {
+		t.Errorf("Unexpected message: %q", err.Errors[0].Message)
+	}
+}
+
+func TestFloatSchema_Finite(t *testing.T) {
+	schema := Float().Finite()
+
+	if err := schema.Validate(1.5, nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+	for _, value := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		err := schema.Validate(value, nil)
+		if err == nil || err.Errors[0].Code != ErrCodeNotFinite {
+			t.Errorf("Expected %s error for %v, got: %v", ErrCodeNotFinite, value, err)
+		}
+	}
+
+	if err := Float().Validate(math.Inf(1), nil); err != nil {
+		t.Errorf("Expected no errors without Finite, got: %v", err)
+	}
+}
+
+func TestFloatSchema_Coerce(t *testing.T) {
+	schema := Float().Coerce()
+
+	type level int
+	for _, value := range []any{3, int64(3), uint8(3), level(3), " 3.0 ", json.Number("3"), 3.0} {
+		got, err := Parse[float64](schema, value)
+		if err != nil || got != 3 {
+			t.Errorf("Expected %v (%T) to parse as 3, got %v, %v", value, value, got, err)
+		}
+	}
+
+	for _, value := range []any{"abc", "NaN", "Inf", true} {
+		err := schema.Validate(value, nil)
+		if err == nil || err.Errors[0].Code != ErrCodeInvalidType {
+			t.Errorf("Expected invalid_type error for %v, got: %v", value, err)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package gozod
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// hangulClass is the Hangul syllable type of a rune, used by the grapheme rules for Korean text
+type hangulClass int
+
+const (
+	hangulNone hangulClass = iota
+	hangulL                // Leading consonant (choseong)
+	hangulV                // Vowel (jungseong)
+	hangulT                // Trailing consonant (jongseong)
+	hangulLV               // Precomposed syllable without a trailing consonant
+	hangulLVT              // Precomposed syllable with a trailing consonant
+)
+
+// hangulClassOf returns the Hangul syllable type of r
+func hangulClassOf(r rune) hangulClass {
+	switch {
+	case r >= 0x1100 && r <= 0x115F, r >= 0xA960 && r <= 0xA97C:
+		return hangulL
+	case r >= 0x1160 && r <= 0x11A7, r >= 0xD7B0 && r <= 0xD7C6:
+		return hangulV
+	case r >= 0x11A8 && r <= 0x11FF, r >= 0xD7CB && r <= 0xD7FB:
+		return hangulT
+	case r >= 0xAC00 && r <= 0xD7A3:
+		if (r-0xAC00)%28 == 0 {
+			return hangulLV
+		}
+		return hangulLVT
+	}
+	return hangulNone
+}
+
+// isGraphemeExtend reports whether r attaches to the preceding character:
+// combining and spacing marks, the zero width non-joiner and emoji components
+func isGraphemeExtend(r rune) bool {
+	return r == 0x200C || isEmojiComponent(r) || unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc)
+}
+
+// isRegionalIndicator reports whether r is one of the letters that pair up into flag emoji
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
+
+// graphemeCount returns the number of user-perceived characters in str
+// It follows the extended grapheme cluster rules of Unicode Standard Annex #29 for CRLF, controls,
+// Hangul syllables, combining marks, emoji ZWJ sequences and flags; prepended concatenation marks
+// are rare enough that they are counted on their own
+func graphemeCount(str string) int {
+	count := 0
+	var prev rune
+	pictographic := false // The cluster so far is a pictograph followed by extenders
+	joined := false       // The previous rune is a ZWJ that follows a pictograph
+	regional := 0         // Regional indicators at the end of the cluster
+
+	for i := 0; i < len(str); {
+		r, size := utf8.DecodeRuneInString(str[i:])
+		i += size
+
+		if count == 0 || graphemeBreak(prev, r, joined, regional) {
+			count++
+			pictographic, regional = false, 0
+		}
+
+		joined = r == 0x200D && pictographic
+		switch {
+		case isEmojiRune(r):
+			pictographic = true
+		case !isGraphemeExtend(r):
+			pictographic = false
+		}
+		if isRegionalIndicator(r) {
+			regional++
+		} else {
+			regional = 0
+		}
+		prev = r
+	}
+	return count
+}
+
+// graphemeBreak reports whether a cluster boundary falls between prev and r
+func graphemeBreak(prev, r rune, joined bool, regional int) bool {
+	switch {
+	case prev == '\r' && r == '\n':
+		return false
+	case unicode.IsControl(prev), unicode.IsControl(r):
+		return true
+	}
+
+	switch prevClass, class := hangulClassOf(prev), hangulClassOf(r); {
+	case prevClass == hangulL && class != hangulNone && class != hangulT:
+		return false
+	case (prevClass == hangulLV || prevClass == hangulV) && (class == hangulV || class == hangulT):
+		return false
+	case (prevClass == hangulLVT || prevClass == hangulT) && class == hangulT:
+		return false
+	}
+
+	switch {
+	case isGraphemeExtend(r):
+		return false
+	case joined && isEmojiRune(r):
+		return false
+	case isRegionalIndicator(r) && regional%2 == 1:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,30 @@
+package gozod
+
+import (
+	"testing"
+)
+
+func TestGraphemeCount(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		count int
+	}{
+		{"ascii", "hello", 5},
+		{"empty", "", 0},
+		{"crlf", "a\r\nb", 3},
+		{"combining accent", "e\u0301te\u0301", 3},
+		{"family emoji", "👨‍👩‍👧‍👦", 1},
+		{"skin tone", "👍🏽", 1},
+		{"flags", "🇯🇵🇫🇷", 2},
+		{"keycap", "1️⃣", 1},
+		{"hangul jamo", "\u1100\u1161\u11A8", 1},
+		{"hangul syllables", "한국", 2},
+		{"mixed", "hi 👋🏻!", 5},
+	}
+	for _, tt := range tests {
+		if got := graphemeCount(tt.input); got != tt.count {
+			t.Errorf("%s: expected %d graphemes in %q, got %d", tt.name, tt.count, tt.input, got)
+		}
+	}
+}
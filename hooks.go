@@ -0,0 +1,33 @@
+package gozod
+
+import (
+	"slices"
+	"sync/atomic"
+)
+
+// errorHook holds the function registered with OnError, or nil
+// It is read on every added error, so it is stored atomically rather than behind a mutex
+var errorHook atomic.Pointer[func(ValidationError)]
+
+// OnError registers fn to be called for every validation error as it is added, by any schema
+// Use it for centralized logging or metrics, e.g. counting errors by code or path
+// The hook receives a copy of the error and cannot alter the returned errors; it must not modify Meta
+// Errors of union options that did not match are reported too, before the invalid_union error summarizing them
+// Passing nil removes the hook. OnError is safe for concurrent use
+func OnError(fn func(ValidationError)) {
+	if fn == nil {
+		errorHook.Store(nil)
+		return
+	}
+	errorHook.Store(&fn)
+}
+
+// reportError passes a copy of err to the hook registered with OnError, if any
+func reportError(err ValidationError) {
+	hook := errorHook.Load()
+	if hook == nil {
+		return
+	}
+	err.Path = slices.Clone(err.Path)
+	(*hook)(err)
+}
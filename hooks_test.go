@@ -0,0 +1,44 @@
+package gozod
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestOnError(t *testing.T) {
+	var mu sync.Mutex
+	counts := map[string]int{}
+	OnError(func(err ValidationError) {
+		mu.Lock()
+		defer mu.Unlock()
+		counts[err.Code]++
+		// Changes to the copy do not reach the returned errors
+		err.Path[0] = "changed"
+	})
+	t.Cleanup(func() { OnError(nil) })
+
+	schema := Map(map[string]Schema{
+		"name": String().Min(3),
+		"age":  Int().Min(18),
+		"tags": Array(String()),
+	})
+	err := schema.Validate(map[string]any{"name": "ab", "age": 10, "tags": []any{1}}, nil)
+	if err == nil || len(err.Errors) != 3 {
+		t.Fatalf("Expected 3 errors, got: %v", err)
+	}
+	for _, e := range err.Errors {
+		if e.Path[0] == "changed" {
+			t.Errorf("Expected the hook not to alter the returned errors, got path %v", e.Path)
+		}
+	}
+	if counts[ErrCodeTooSmall] != 2 || counts[ErrCodeInvalidType] != 1 {
+		t.Errorf("Expected 2 too_small and 1 invalid_type, got %v", counts)
+	}
+
+	// Removing the hook stops the calls
+	OnError(nil)
+	_ = String().Validate(1, nil)
+	if counts[ErrCodeInvalidType] != 1 {
+		t.Errorf("Expected no calls after removing the hook, got %v", counts)
+	}
+}
@@ -0,0 +1,52 @@
+package gozod
+
+// isNanoID reports whether str is a NanoID of the given length using the default URL-safe alphabet
+func isNanoID(str string, length int) bool {
+	if len(str) != length {
+		return false
+	}
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '_' || c == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+// isCUID2 reports whether str is a CUID2: a lowercase letter followed by lowercase
+// letters and digits, 2 to 32 characters in total
+func isCUID2(str string) bool {
+	if len(str) < 2 || len(str) > 32 || str[0] < 'a' || str[0] > 'z' {
+		return false
+	}
+	for i := 1; i < len(str); i++ {
+		c := str[i]
+		if !('a' <= c && c <= 'z' || '0' <= c && c <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// isULID reports whether str is a 26-character ULID in Crockford base32
+// Letters are case-insensitive; I, L, O and U are not part of the alphabet,
+// and the first character is at most 7 because a ULID encodes 128 bits
+func isULID(str string) bool {
+	if len(str) != 26 || str[0] < '0' || str[0] > '7' {
+		return false
+	}
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		if 'a' <= c && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		switch {
+		case '0' <= c && c <= '9':
+		case 'A' <= c && c <= 'Z' && c != 'I' && c != 'L' && c != 'O' && c != 'U':
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -1,7 +1,11 @@
 package gozod
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"math"
+	"math/big"
 )
 
 // IntSchema validates integer values
@@ -9,11 +13,17 @@ type IntSchema struct {
 	BaseSchema
 	min         *int64
 	max         *int64
+	gt          *int64 // Exclusive lower bound
+	lt          *int64 // Exclusive upper bound
 	positive    bool
 	negative    bool
 	nonNegative bool
 	nonPositive bool
 	multipleOf  *int64
+	// unsigned validates against the uint64 range instead of int64
+	unsigned bool
+	step     *int64
+	safeInt  bool
 }
 
 // Int creates a new integer schema
@@ -29,6 +39,13 @@ func (s *IntSchema) Nilable() *IntSchema {
 	return s
 }
 
+// Optional allows missing values for IntSchema without declaring null a valid value
+// Validation accepts nil as with Nilable; the difference shows in IsOptional and IsNilable
+func (s *IntSchema) Optional() *IntSchema {
+	s.required = false
+	return s
+}
+
 // Min sets the minimum value for IntSchema
 func (s *IntSchema) Min(value int64) *IntSchema {
 	s.min = &value
@@ -41,6 +58,29 @@ func (s *IntSchema) Max(value int64) *IntSchema {
 	return s
 }
 
+// Between sets the minimum and maximum value at once
+// It panics if min is greater than max
+func (s *IntSchema) Between(min, max int64) *IntSchema {
+	if min > max {
+		panic(fmt.Sprintf("gozod: Between requires min <= max, got %v and %v", min, max))
+	}
+	s.min = &min
+	s.max = &max
+	return s
+}
+
+// GreaterThan sets an exclusive lower bound: the value must be strictly greater than value
+func (s *IntSchema) GreaterThan(value int64) *IntSchema {
+	s.gt = &value
+	return s
+}
+
+// LessThan sets an exclusive upper bound: the value must be strictly less than value
+func (s *IntSchema) LessThan(value int64) *IntSchema {
+	s.lt = &value
+	return s
+}
+
 // Positive validates that the number is positive (> 0) for IntSchema
 func (s *IntSchema) Positive() *IntSchema {
 	s.positive = true
@@ -65,18 +105,68 @@ func (s *IntSchema) NonPositive() *IntSchema {
 	return s
 }
 
+// SafeInt validates that the number is within ±MaxSafeInteger, the range JavaScript can represent exactly
+// Use it for data consumed by JavaScript clients, where larger integers lose precision
+func (s *IntSchema) SafeInt() *IntSchema {
+	s.safeInt = true
+	return s
+}
+
 // MultipleOf validates that the number is a multiple of the given value for IntSchema
+// A negative value is treated as its absolute value; zero panics since nothing is a multiple of it
 func (s *IntSchema) MultipleOf(value int64) *IntSchema {
+	if value == 0 {
+		panic("gozod: MultipleOf requires a non-zero value")
+	}
+	if value < 0 && value != math.MinInt64 {
+		value = -value
+	}
 	s.multipleOf = &value
 	return s
 }
 
+// Step validates that the number is Min plus a whole number of steps (min + k*step)
+// Without Min the base is zero, which makes it equivalent to MultipleOf
+// A negative step is treated as its absolute value; zero panics
+func (s *IntSchema) Step(step int64) *IntSchema {
+	if step == 0 {
+		panic("gozod: Step requires a non-zero value")
+	}
+	if step < 0 && step != math.MinInt64 {
+		step = -step
+	}
+	s.step = &step
+	return s
+}
+
+// AcceptWholeFloat accepts float values with no fractional part (e.g. 42.0) as integers
+//
+// Deprecated: whole floats and integral json.Number values are accepted by default
+func (s *IntSchema) AcceptWholeFloat() *IntSchema {
+	return s
+}
+
+// Unsigned validates against the uint64 range instead of int64
+// Values up to math.MaxUint64 are accepted and negative values fail with ErrCodeTooSmall
+func (s *IntSchema) Unsigned() *IntSchema {
+	s.unsigned = true
+	return s
+}
+
 // Refine adds a custom validation function
 // The function receives the value and returns (isValid, errorMessage)
 // If isValid is false, validation fails with the provided errorMessage
 // If errorMessage is empty, a default message will be used
-func (s *IntSchema) Refine(validator RefineFunc) *IntSchema {
-	s.BaseSchema.addRefinement(validator)
+// Options such as OnlyIfValid and AbortOnFailure control when it runs
+func (s *IntSchema) Refine(validator RefineFunc, opts ...RefineOption) *IntSchema {
+	s.BaseSchema.addRefinement(validator, opts...)
+	return s
+}
+
+// UseRefinement attaches a refinement registered with RegisterRefinement
+// It panics if no refinement is registered under name
+func (s *IntSchema) UseRefinement(name string) *IntSchema {
+	s.BaseSchema.useRefinement(name)
 	return s
 }
 
@@ -88,23 +178,60 @@ func (s *IntSchema) SuperRefine(validator SuperRefineFunc) *IntSchema {
 	return s
 }
 
+// AsyncRefine adds a context-aware validation function
+// The function receives the context passed to ValidateCtx and is awaited before validation returns
+// Use it for expensive checks (e.g. database lookups) that need cancellation or deadlines
+func (s *IntSchema) AsyncRefine(validator AsyncRefineFunc) *IntSchema {
+	s.BaseSchema.addAsyncRefinement(validator)
+	return s
+}
+
+// RefineWithParent adds a validation function that also receives the enclosing object
+// It runs when the schema validates a field of a Map or Struct and the field itself is valid,
+// including a nil value on a Nilable field, which makes it suitable for conditionally required fields
+func (s *IntSchema) RefineWithParent(validator ParentRefineFunc) *IntSchema {
+	s.BaseSchema.addParentRefinement(validator)
+	return s
+}
+
+// Or returns a union that accepts values matching either s or other
+func (s *IntSchema) Or(other Schema) *UnionSchema {
+	return Union(s, other)
+}
+
+// And returns an intersection that requires values to match both s and other
+func (s *IntSchema) And(other Schema) *IntersectionSchema {
+	return Intersection(s, other)
+}
+
 // Validate validates a value against the int schema
 func (s *IntSchema) Validate(value any, path []any) *ValidationErrors {
-	errors := &ValidationErrors{}
+	return s.ValidateCtx(context.Background(), value, path)
+}
+
+// ValidateCtx validates a value using ctx for cancellation and request scoping
+func (s *IntSchema) ValidateCtx(ctx context.Context, value any, path []any) *ValidationErrors {
+	// Errors are collected in a local value and only escape to the heap when validation fails
+	var errors ValidationErrors
 
 	// Handle nil/nilable
 	if value == nil {
-		if !s.nilable {
+		if !s.allowsNil() {
 			msg := s.getErrorMessage(path, ErrCodeRequired, "Required")
 			errors.Add(path, ErrCodeRequired, msg)
-			return errors
+			return errors.orNil()
 		}
 		return nil
 	}
 
+	value = s.coerceWholeNumber(value)
+
 	// Convert to int64 for validation
+	// In unsigned mode, uint64 values above math.MaxInt64 are kept in large instead
 	var num int64
 	var isInt bool
+	var large uint64
+	isLarge := false
 
 	switch v := value.(type) {
 	case int:
@@ -123,7 +250,12 @@ func (s *IntSchema) Validate(value any, path []any) *ValidationErrors {
 		num = v
 		isInt = true
 	case uint:
-		num = int64(v)
+		// uint is 64 bits wide on most platforms, so it can exceed math.MaxInt64 like uint64
+		if num, large, isLarge = splitUint64(uint64(v)); isLarge && !s.unsigned {
+			msg := s.getErrorMessage(path, ErrCodeInvalidType, fmt.Sprintf("Expected integer, got %T", value))
+			errors.Add(path, ErrCodeInvalidType, msg)
+			return errors.orNil()
+		}
 		isInt = true
 	case uint8:
 		num = int64(v)
@@ -136,89 +268,228 @@ func (s *IntSchema) Validate(value any, path []any) *ValidationErrors {
 		isInt = true
 	case uint64:
 		// Check if uint64 can fit in int64
-		if v > uint64(9223372036854775807) {
+		if num, large, isLarge = splitUint64(v); isLarge && !s.unsigned {
 			msg := s.getErrorMessage(path, ErrCodeInvalidType, fmt.Sprintf("Expected integer, got %T", value))
 			errors.Add(path, ErrCodeInvalidType, msg)
-			return errors
+			return errors.orNil()
 		}
-		num = int64(v)
 		isInt = true
 	case float32:
 		// Reject floats
 		msg := s.getErrorMessage(path, ErrCodeInvalidType, "Expected integer, got float")
 		errors.Add(path, ErrCodeInvalidType, msg)
-		return errors
+		return errors.orNil()
 	case float64:
 		// Reject floats
 		msg := s.getErrorMessage(path, ErrCodeInvalidType, "Expected integer, got float")
 		errors.Add(path, ErrCodeInvalidType, msg)
-		return errors
+		return errors.orNil()
 	default:
 		msg := s.getErrorMessage(path, ErrCodeInvalidType, fmt.Sprintf("Expected integer, got %T", value))
 		errors.Add(path, ErrCodeInvalidType, msg)
-		return errors
+		return errors.orNil()
 	}
 
 	if !isInt {
 		msg := s.getErrorMessage(path, ErrCodeInvalidType, "Expected integer, got float")
 		errors.Add(path, ErrCodeInvalidType, msg)
-		return errors
+		return errors.orNil()
+	}
+
+	// Unsigned range validation
+	if s.unsigned && !isLarge && num < 0 {
+		meta := map[string]any{"constraint": "unsigned", "minimum": 0, "inclusive": true, "actual": num}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeTooSmall, fmt.Sprintf("Number must be unsigned (>= 0), got %v", num), meta)
+		errors.AddWithMeta(path, ErrCodeTooSmall, msg, meta)
+	}
+
+	// Values above math.MaxInt64 are bigger than any int64 bound, so they are reported as is
+	var display any = num
+	if isLarge {
+		display = large
 	}
 
 	// Min validation
-	if s.min != nil && num < *s.min {
-		msg := s.getErrorMessage(path, ErrCodeTooSmall, fmt.Sprintf("Number must be greater than or equal to %v, got %v", *s.min, num))
-		errors.Add(path, ErrCodeTooSmall, msg)
+	if s.min != nil && !isLarge && num < *s.min {
+		meta := map[string]any{"minimum": *s.min, "inclusive": true, "actual": num}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeTooSmall, fmt.Sprintf("Number must be greater than or equal to %v, got %v", *s.min, num), meta)
+		errors.AddWithMeta(path, ErrCodeTooSmall, msg, meta)
 	}
 
 	// Max validation
-	if s.max != nil && num > *s.max {
-		msg := s.getErrorMessage(path, ErrCodeTooBig, fmt.Sprintf("Number must be less than or equal to %v, got %v", *s.max, num))
-		errors.Add(path, ErrCodeTooBig, msg)
+	if s.max != nil && (isLarge || num > *s.max) {
+		meta := map[string]any{"maximum": *s.max, "inclusive": true, "actual": display}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeTooBig, fmt.Sprintf("Number must be less than or equal to %v, got %v", *s.max, display), meta)
+		errors.AddWithMeta(path, ErrCodeTooBig, msg, meta)
+	}
+
+	// GreaterThan validation
+	if s.gt != nil && !isLarge && num <= *s.gt {
+		meta := map[string]any{"minimum": *s.gt, "inclusive": false, "actual": num}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeTooSmall, fmt.Sprintf("Number must be greater than %v, got %v", *s.gt, num), meta)
+		errors.AddWithMeta(path, ErrCodeTooSmall, msg, meta)
+	}
+
+	// LessThan validation
+	if s.lt != nil && (isLarge || num >= *s.lt) {
+		meta := map[string]any{"maximum": *s.lt, "inclusive": false, "actual": display}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeTooBig, fmt.Sprintf("Number must be less than %v, got %v", *s.lt, display), meta)
+		errors.AddWithMeta(path, ErrCodeTooBig, msg, meta)
 	}
 
 	// Positive validation
-	if s.positive && num <= 0 {
-		msg := s.getErrorMessage(path, ErrCodeTooSmall, fmt.Sprintf("Number must be positive (> 0), got %v", num))
-		errors.Add(path, ErrCodeTooSmall, msg)
+	if s.positive && !isLarge && num <= 0 {
+		meta := map[string]any{"constraint": "positive", "minimum": 0, "inclusive": false, "actual": num}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeNotPositive, fmt.Sprintf("Number must be positive (> 0), got %v", num), meta)
+		errors.AddWithMeta(path, ErrCodeNotPositive, msg, meta)
 	}
 
 	// Negative validation
-	if s.negative && num >= 0 {
-		msg := s.getErrorMessage(path, ErrCodeTooBig, fmt.Sprintf("Number must be negative (< 0), got %v", num))
-		errors.Add(path, ErrCodeTooBig, msg)
+	if s.negative && (isLarge || num >= 0) {
+		meta := map[string]any{"constraint": "negative", "maximum": 0, "inclusive": false, "actual": display}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeNotNegative, fmt.Sprintf("Number must be negative (< 0), got %v", display), meta)
+		errors.AddWithMeta(path, ErrCodeNotNegative, msg, meta)
 	}
 
 	// NonNegative validation
-	if s.nonNegative && num < 0 {
-		msg := s.getErrorMessage(path, ErrCodeTooSmall, fmt.Sprintf("Number must be non-negative (>= 0), got %v", num))
-		errors.Add(path, ErrCodeTooSmall, msg)
+	if s.nonNegative && !isLarge && num < 0 {
+		meta := map[string]any{"constraint": "nonNegative", "minimum": 0, "inclusive": true, "actual": num}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeNotNonNegative, fmt.Sprintf("Number must be non-negative (>= 0), got %v", num), meta)
+		errors.AddWithMeta(path, ErrCodeNotNonNegative, msg, meta)
 	}
 
 	// NonPositive validation
-	if s.nonPositive && num > 0 {
-		msg := s.getErrorMessage(path, ErrCodeTooBig, fmt.Sprintf("Number must be non-positive (<= 0), got %v", num))
-		errors.Add(path, ErrCodeTooBig, msg)
+	if s.nonPositive && (isLarge || num > 0) {
+		meta := map[string]any{"constraint": "nonPositive", "maximum": 0, "inclusive": true, "actual": display}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeNotNonPositive, fmt.Sprintf("Number must be non-positive (<= 0), got %v", display), meta)
+		errors.AddWithMeta(path, ErrCodeNotNonPositive, msg, meta)
+	}
+
+	// SafeInt validation
+	if s.safeInt && (isLarge || num > MaxSafeInteger || num < -MaxSafeInteger) {
+		msg := s.getErrorMessage(path, ErrCodeNotSafeInteger, fmt.Sprintf("Number must be a safe integer (between -%d and %d), got %v", MaxSafeInteger, MaxSafeInteger, display))
+		errors.Add(path, ErrCodeNotSafeInteger, msg)
 	}
 
 	// MultipleOf validation
 	if s.multipleOf != nil {
-		if num%*s.multipleOf != 0 {
-			msg := s.getErrorMessage(path, ErrCodeInvalidType, fmt.Sprintf("Number must be a multiple of %v, got %v", *s.multipleOf, num))
-			errors.Add(path, ErrCodeInvalidType, msg)
+		notMultiple := num%*s.multipleOf != 0
+		if isLarge {
+			step := *s.multipleOf
+			if step < 0 {
+				step = -step
+			}
+			notMultiple = large%uint64(step) != 0
+		}
+		if notMultiple {
+			msg := s.getErrorMessage(path, ErrCodeNotMultipleOf, fmt.Sprintf("Number must be a multiple of %v, got %v", *s.multipleOf, display))
+			errors.Add(path, ErrCodeNotMultipleOf, msg)
+		}
+	}
+
+	// Step validation
+	if s.step != nil {
+		base := int64(0)
+		if s.min != nil {
+			base = *s.min
+		}
+		// The offset from the base can exceed int64, so it is computed with big integers
+		offset := new(big.Int).SetInt64(num)
+		if isLarge {
+			offset.SetUint64(large)
+		}
+		offset.Sub(offset, big.NewInt(base))
+		if offset.Rem(offset, big.NewInt(*s.step)).Sign() != 0 {
+			meta := map[string]any{"step": *s.step, "base": base}
+			msg := s.getErrorMessageWithMeta(path, ErrCodeNotMultipleOf, fmt.Sprintf("Number must be %v plus a multiple of %v, got %v", base, *s.step, display), meta)
+			errors.AddWithMeta(path, ErrCodeNotMultipleOf, msg, meta)
 		}
 	}
 
 	// Apply custom refinements (only if type check passed)
-	s.applyRefinements(value, path, errors)
+	s.applyRefinements(value, path, &errors)
+
+	// Apply async refinements (only if type check passed)
+	s.applyAsyncRefinements(ctx, value, path, &errors)
 
 	// Apply super refinements (only if type check passed)
-	s.applySuperRefinements(value, path, errors)
+	s.applySuperRefinements(ctx, value, path, &errors)
 
-	if len(errors.Errors) == 0 {
-		return nil
+	return errors.orNil()
+}
+
+// parse validates value and returns it, converted to int if it was a whole float or json.Number
+func (s *IntSchema) parse(ctx context.Context, value any, path []any) (any, *ValidationErrors) {
+	value = s.coerceWholeNumber(value)
+	if errs := s.ValidateCtx(ctx, value, path); errs != nil {
+		return nil, errs
 	}
-	return errors
+	return value, nil
+}
+
+// splitUint64 returns v as an int64, or as large with isLarge set when it is above math.MaxInt64
+func splitUint64(v uint64) (num int64, large uint64, isLarge bool) {
+	if v > math.MaxInt64 {
+		return 0, v, true
+	}
+	return int64(v), 0, false
+}
+
+// coerceWholeNumber converts whole floats and json.Number values within the int64 range to int
+// Decoding JSON into any yields float64 (or json.Number with UseNumber) for every number,
+// so integers coming from JSON are accepted without extra configuration
+// Fractional values are returned as float64 so they are rejected as floats
+func (s *IntSchema) coerceWholeNumber(value any) any {
+	var f float64
+	switch v := value.(type) {
+	case float32:
+		f = float64(v)
+	case float64:
+		f = v
+	case json.Number:
+		if n, err := v.Int64(); err == nil && n >= math.MinInt && n <= math.MaxInt {
+			return int(n)
+		}
+		parsed, err := v.Float64()
+		if err != nil {
+			return value
+		}
+		f = parsed
+		value = parsed
+	default:
+		return value
+	}
+	if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+		return value
+	}
+	return int(f)
+}
+
+// Title sets a short human-readable title used in generated documentation
+func (s *IntSchema) Title(title string) *IntSchema {
+	s.annotations.Title = title
+	return s
+}
+
+// Describe sets a description used in generated documentation
+// It does not affect validation
+func (s *IntSchema) Describe(description string) *IntSchema {
+	s.annotations.Description = description
+	return s
+}
+
+// Example adds an example value used in generated documentation
+func (s *IntSchema) Example(example any) *IntSchema {
+	s.annotations.Examples = append(s.annotations.Examples, example)
+	return s
+}
+
+// Clone returns an independent copy of the schema
+// Constraints, refinements and custom errors are copied, so changing the clone does not affect s
+func (s *IntSchema) Clone() *IntSchema {
+	c := *s
+	c.BaseSchema = s.BaseSchema.clone()
+	return &c
 }
 
 // CustomError sets a custom error message for a specific error code for IntSchema
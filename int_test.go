@@ -1,6 +1,10 @@
 package gozod
 
 import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -334,3 +338,313 @@ func TestIntSchema_ZeroValue(t *testing.T) {
 		t.Error("Expected error for zero with Positive()")
 	}
 }
+
+func TestIntSchema_AcceptWholeFloat(t *testing.T) {
+	schema := Int().AcceptWholeFloat().Min(10)
+
+	if err := schema.Validate(42.0, nil); err != nil {
+		t.Errorf("Expected no errors for 42.0, got: %v", err)
+	}
+
+	err := schema.Validate(42.5, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeInvalidType {
+		t.Errorf("Expected %s error for 42.5, got: %v", ErrCodeInvalidType, err)
+	}
+
+	// Checks run against the converted value
+	err = schema.Validate(5.0, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeTooSmall {
+		t.Errorf("Expected %s error for 5.0, got: %v", ErrCodeTooSmall, err)
+	}
+
+	// Parse returns the int
+	n, errs := Parse[int](schema, 42.0)
+	if errs != nil || n != 42 {
+		t.Errorf("Expected 42, got %v (%v)", n, errs)
+	}
+
+	// The option is deprecated; whole floats are accepted without it
+	if err := Int().Validate(42.0, nil); err != nil {
+		t.Errorf("Expected no errors for 42.0 without AcceptWholeFloat, got: %v", err)
+	}
+}
+
+func TestIntSchema_JSONNumbers(t *testing.T) {
+	schema := Map(map[string]Schema{
+		"count": Int().Min(1),
+	})
+
+	var decoded map[string]any
+	if err := json.Unmarshal([]byte(`{"count": 3}`), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if err := schema.Validate(decoded, nil); err != nil {
+		t.Errorf("Expected no errors for float64 from json.Unmarshal, got: %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"count": 3.5}`), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	err := schema.Validate(decoded, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeInvalidType {
+		t.Errorf("Expected %s error for 3.5, got: %v", ErrCodeInvalidType, err)
+	}
+
+	// json.Number values from a decoder with UseNumber
+	tests := []struct {
+		input string
+		valid bool
+	}{
+		{"7", true},
+		{"1e3", true},
+		{"7.0", true},
+		{"7.25", false},
+		{"0", false}, // below Min(1)
+	}
+	for _, tt := range tests {
+		dec := json.NewDecoder(strings.NewReader(`{"count": ` + tt.input + `}`))
+		dec.UseNumber()
+		var m map[string]any
+		if err := dec.Decode(&m); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := m["count"].(json.Number); !ok {
+			t.Fatalf("Expected json.Number, got %T", m["count"])
+		}
+		err := schema.Validate(m, nil)
+		if tt.valid && err != nil {
+			t.Errorf("Expected %s to be valid, got: %v", tt.input, err)
+		}
+		if !tt.valid && err == nil {
+			t.Errorf("Expected %s to be invalid", tt.input)
+		}
+	}
+
+	// Parse converts json.Number to int
+	n, errs := Parse[int](Int(), json.Number("42"))
+	if errs != nil || n != 42 {
+		t.Errorf("Expected 42, got %v (%v)", n, errs)
+	}
+}
+
+func TestIntSchema_Unsigned(t *testing.T) {
+	schema := Int().Unsigned()
+
+	for _, value := range []any{uint64(18446744073709551615), uint64(math.MaxInt64) + 1, uint(math.MaxUint), 0, 42} {
+		if err := schema.Validate(value, nil); err != nil {
+			t.Errorf("Expected no errors for %v, got: %v", value, err)
+		}
+	}
+
+	err := schema.Validate(-1, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeTooSmall {
+		t.Errorf("Expected %s error for -1, got: %v", ErrCodeTooSmall, err)
+	}
+
+	// Large values compare above any int64 bound
+	err = Int().Unsigned().Max(100).Validate(uint64(math.MaxUint64), nil)
+	if err == nil || err.Errors[0].Code != ErrCodeTooBig {
+		t.Errorf("Expected %s error, got: %v", ErrCodeTooBig, err)
+	}
+	if err := Int().Unsigned().Min(100).Positive().Validate(uint64(math.MaxUint64), nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+	if err := Int().Unsigned().MultipleOf(5).Validate(uint64(math.MaxUint64), nil); err != nil {
+		t.Errorf("Expected MaxUint64 to be a multiple of 5, got: %v", err)
+	}
+	if err := Int().Unsigned().MultipleOf(2).Validate(uint64(math.MaxUint64), nil); err == nil {
+		t.Error("Expected MaxUint64 not to be a multiple of 2")
+	}
+
+	// The default signed behavior is unchanged
+	err = Int().Validate(uint64(math.MaxUint64), nil)
+	if err == nil || err.Errors[0].Code != ErrCodeInvalidType {
+		t.Errorf("Expected %s error without Unsigned, got: %v", ErrCodeInvalidType, err)
+	}
+
+	// uint values above math.MaxInt64 must not wrap to negative numbers
+	if strconv.IntSize == 64 {
+		err = Int().Validate(uint(math.MaxUint), nil)
+		if err == nil || err.Errors[0].Code != ErrCodeInvalidType {
+			t.Errorf("Expected %s error for a large uint without Unsigned, got: %v", ErrCodeInvalidType, err)
+		}
+		err = Int().Unsigned().Max(100).Validate(uint(math.MaxUint), nil)
+		if err == nil || err.Errors[0].Code != ErrCodeTooBig || err.Errors[0].Meta["actual"] != uint64(math.MaxUint64) {
+			t.Errorf("Expected %s error reporting MaxUint64, got: %v", ErrCodeTooBig, err)
+		}
+	}
+}
+
+func TestIntSchema_MultipleOf_Guards(t *testing.T) {
+	// Negative multiples behave like their absolute value
+	schema := Int().MultipleOf(-5)
+	if err := schema.Validate(10, nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+	if err := schema.Validate(7, nil); err == nil {
+		t.Error("Expected error for 7")
+	}
+
+	// Negative inputs
+	if err := Int().MultipleOf(5).Validate(-15, nil); err != nil {
+		t.Errorf("Expected no errors for -15, got: %v", err)
+	}
+	err := Int().MultipleOf(5).Validate(-7, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeNotMultipleOf {
+		t.Errorf("Expected %s error for -7, got: %v", ErrCodeNotMultipleOf, err)
+	}
+	if err := Int().MultipleOf(math.MinInt64).Validate(math.MinInt64, nil); err != nil {
+		t.Errorf("Expected no errors for MinInt64, got: %v", err)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for MultipleOf(0)")
+		}
+	}()
+	Int().MultipleOf(0)
+}
+
+func TestIntSchema_Step(t *testing.T) {
+	// Values of the form 3 + k*5
+	schema := Int().Min(3).Step(5)
+	for _, value := range []int{3, 8, 13} {
+		if err := schema.Validate(value, nil); err != nil {
+			t.Errorf("Expected no errors for %d, got: %v", value, err)
+		}
+	}
+	err := schema.Validate(10, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeNotMultipleOf {
+		t.Errorf("Expected %s error for 10, got: %v", ErrCodeNotMultipleOf, err)
+	}
+
+	// Without Min the base is zero, and negative bases work
+	if err := Int().Step(5).Validate(10, nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+	if err := Int().Min(-2).Step(4).Validate(6, nil); err != nil {
+		t.Errorf("Expected no errors for 6 from base -2, got: %v", err)
+	}
+
+	// The offset from the base may not fit in int64
+	if err := Int().Unsigned().Min(math.MinInt64).Step(1).Validate(uint64(math.MaxUint64), nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+}
+
+func TestIntSchema_Between(t *testing.T) {
+	schema := Int().Between(1, 10)
+
+	for _, value := range []int{1, 5, 10} {
+		if err := schema.Validate(value, nil); err != nil {
+			t.Errorf("Expected no errors for %d, got: %v", value, err)
+		}
+	}
+
+	err := schema.Validate(0, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeTooSmall {
+		t.Errorf("Expected %s error for 0, got: %v", ErrCodeTooSmall, err)
+	}
+	err = schema.Validate(11, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeTooBig {
+		t.Errorf("Expected %s error for 11, got: %v", ErrCodeTooBig, err)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for Between(10, 1)")
+		}
+	}()
+	Int().Between(10, 1)
+}
+
+func TestIntSchema_SignCodes(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema *IntSchema
+		value  int
+		code   string
+	}{
+		{"Positive", Int().Positive(), 0, ErrCodeNotPositive},
+		{"Negative", Int().Negative(), 0, ErrCodeNotNegative},
+		{"NonNegative", Int().NonNegative(), -1, ErrCodeNotNonNegative},
+		{"NonPositive", Int().NonPositive(), 1, ErrCodeNotNonPositive},
+	}
+	for _, tt := range tests {
+		err := tt.schema.Validate(tt.value, nil)
+		if err == nil || err.Errors[0].Code != tt.code {
+			t.Errorf("%s: expected %s error, got: %v", tt.name, tt.code, err)
+			continue
+		}
+		// Meta names the rule and the offending value
+		meta := err.Errors[0].Meta
+		constraint := strings.ToLower(tt.name[:1]) + tt.name[1:]
+		if meta["constraint"] != constraint || meta["actual"] != int64(tt.value) {
+			t.Errorf("%s: expected constraint %q and actual %v in meta, got %v", tt.name, constraint, tt.value, meta)
+		}
+	}
+
+	// The positive message can be customized without touching the min message
+	schema := Int().Min(-10).Positive().
+		CustomError(ErrCodeNotPositive, "Must be positive")
+	err := schema.Validate(0, nil)
+	if err == nil || err.Errors[0].Message != "Must be positive" {
+		t.Errorf("Expected custom positive message, got: %v", err)
+	}
+	err = schema.Validate(-20, nil)
+	if err == nil || len(err.Errors) != 2 {
+		t.Fatalf("Expected min and positive errors, got: %v", err)
+	}
+	if err.Errors[0].Code != ErrCodeTooSmall || err.Errors[0].Message == "Must be positive" {
+		t.Errorf("Expected default too_small message, got: %v", err.Errors[0])
+	}
+}
+
+func TestIntSchema_ExclusiveBounds(t *testing.T) {
+	schema := Int().GreaterThan(0).LessThan(10)
+
+	for _, value := range []int{1, 9} {
+		if err := schema.Validate(value, nil); err != nil {
+			t.Errorf("Expected no errors for %d, got: %v", value, err)
+		}
+	}
+	err := schema.Validate(0, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeTooSmall {
+		t.Errorf("Expected %s error for 0, got: %v", ErrCodeTooSmall, err)
+	}
+	err = schema.Validate(10, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeTooBig {
+		t.Errorf("Expected %s error for 10, got: %v", ErrCodeTooBig, err)
+	}
+
+	// Values above math.MaxInt64 exceed any exclusive upper bound
+	if err := Int().Unsigned().LessThan(10).Validate(uint64(math.MaxUint64), nil); err == nil {
+		t.Error("Expected error for MaxUint64 with LessThan(10)")
+	}
+}
+
+func TestIntSchema_SafeInt(t *testing.T) {
+	schema := Int().SafeInt()
+
+	for _, value := range []any{0, MaxSafeInteger, -MaxSafeInteger, int64(42)} {
+		if err := schema.Validate(value, nil); err != nil {
+			t.Errorf("Expected %v to be safe, got: %v", value, err)
+		}
+	}
+	for _, value := range []any{int64(MaxSafeInteger + 1), int64(-MaxSafeInteger - 1)} {
+		err := schema.Validate(value, nil)
+		if err == nil || err.Errors[0].Code != ErrCodeNotSafeInteger {
+			t.Errorf("Expected %s error for %v, got: %v", ErrCodeNotSafeInteger, value, err)
+		}
+	}
+
+	err := Int().Unsigned().SafeInt().Validate(uint64(math.MaxUint64), nil)
+	if err == nil || err.Errors[0].Code != ErrCodeNotSafeInteger {
+		t.Errorf("Expected %s error for MaxUint64, got: %v", ErrCodeNotSafeInteger, err)
+	}
+
+	// Without SafeInt large values are accepted
+	if err := Int().Validate(int64(MaxSafeInteger+1), nil); err != nil {
+		t.Errorf("Expected no errors without SafeInt, got: %v", err)
+	}
+}
@@ -0,0 +1,236 @@
+package gozod
+
+import (
+	"context"
+	"maps"
+	"slices"
+)
+
+// IntersectionSchema validates that a value matches every one of several schemas
+type IntersectionSchema struct {
+	BaseSchema
+	schemas []Schema
+}
+
+// Intersection creates a schema that passes only if the value matches all of the given schemas
+// Every schema is run and their errors are concatenated in order
+// The schemas are copied, so later changes to the slice do not affect the schema
+//
+// With object schemas, every member validates the whole object, so each field set must pass.
+// A Strict member rejects the keys that only another member declares, so a strict member fails
+// on any value the others need; keep members non-strict and use Extend for one strict shape.
+// Parse merges the map outputs of the members, and a later schema wins when several produce
+// the same key; for other schemas Parse returns the first schema's output
+func Intersection(schemas ...Schema) *IntersectionSchema {
+	return &IntersectionSchema{
+		BaseSchema: BaseSchema{required: true},
+		schemas:    slices.Clone(schemas),
+	}
+}
+
+// Nilable allows null values
+func (s *IntersectionSchema) Nilable() *IntersectionSchema {
+	s.nilable = true
+	return s
+}
+
+// Optional allows missing values without declaring null a valid value
+// Validation accepts nil as with Nilable; the difference shows in IsOptional and IsNilable
+func (s *IntersectionSchema) Optional() *IntersectionSchema {
+	s.required = false
+	return s
+}
+
+// Refine adds a custom validation function
+// The function receives the value and returns (isValid, errorMessage)
+// If isValid is false, validation fails with the provided errorMessage
+// If errorMessage is empty, a default message will be used
+// Options such as OnlyIfValid and AbortOnFailure control when it runs
+func (s *IntersectionSchema) Refine(validator RefineFunc, opts ...RefineOption) *IntersectionSchema {
+	s.BaseSchema.addRefinement(validator, opts...)
+	return s
+}
+
+// UseRefinement attaches a refinement registered with RegisterRefinement
+// It panics if no refinement is registered under name
+func (s *IntersectionSchema) UseRefinement(name string) *IntersectionSchema {
+	s.BaseSchema.useRefinement(name)
+	return s
+}
+
+// SuperRefine adds a super refinement validation function
+// Similar to Refine, but provides a context object for adding errors with custom paths and codes
+// This allows for more fine-grained control over error reporting, similar to Zod's superRefine
+func (s *IntersectionSchema) SuperRefine(validator SuperRefineFunc) *IntersectionSchema {
+	s.BaseSchema.addSuperRefinement(validator)
+	return s
+}
+
+// AsyncRefine adds a context-aware validation function
+// The function receives the context passed to ValidateCtx and is awaited before validation returns
+// Use it for expensive checks (e.g. database lookups) that need cancellation or deadlines
+func (s *IntersectionSchema) AsyncRefine(validator AsyncRefineFunc) *IntersectionSchema {
+	s.BaseSchema.addAsyncRefinement(validator)
+	return s
+}
+
+// RefineWithParent adds a validation function that also receives the enclosing object
+// It runs when the schema validates a field of a Map or Struct and the field itself is valid,
+// including a nil value on a Nilable field, which makes it suitable for conditionally required fields
+func (s *IntersectionSchema) RefineWithParent(validator ParentRefineFunc) *IntersectionSchema {
+	s.BaseSchema.addParentRefinement(validator)
+	return s
+}
+
+// Or returns a union that accepts values matching either s or other
+func (s *IntersectionSchema) Or(other Schema) *UnionSchema {
+	return Union(s, other)
+}
+
+// And adds other to the schemas the value must match, so chained And calls build a single flat intersection
+func (s *IntersectionSchema) And(other Schema) *IntersectionSchema {
+	s.schemas = append(s.schemas, other)
+	return s
+}
+
+// Validate validates a value against the intersection schema
+func (s *IntersectionSchema) Validate(value any, path []any) *ValidationErrors {
+	return s.ValidateCtx(context.Background(), value, path)
+}
+
+// ValidateCtx validates a value using ctx for cancellation and request scoping
+func (s *IntersectionSchema) ValidateCtx(ctx context.Context, value any, path []any) *ValidationErrors {
+	// Errors are collected in a local value and only escape to the heap when validation fails
+	var errors ValidationErrors
+
+	// Handle nil/nilable
+	if value == nil {
+		if !s.allowsNil() {
+			msg := s.getErrorMessage(path, ErrCodeRequired, "Required")
+			errors.Add(path, ErrCodeRequired, msg)
+			return errors.orNil()
+		}
+		return nil
+	}
+
+	// Run every schema, keeping all of their errors
+	for _, schema := range s.schemas {
+		if schemaErr := schema.ValidateCtx(ctx, value, path); schemaErr != nil {
+			errors.Errors = append(errors.Errors, schemaErr.Errors...)
+		}
+	}
+	if errors.HasErrors() {
+		return errors.orNil()
+	}
+
+	// Apply custom refinements (only if every schema matched)
+	s.applyRefinements(value, path, &errors)
+
+	// Apply async refinements (only if every schema matched)
+	s.applyAsyncRefinements(ctx, value, path, &errors)
+
+	// Apply super refinements (only if every schema matched)
+	s.applySuperRefinements(ctx, value, path, &errors)
+
+	return errors.orNil()
+}
+
+// parse validates value against every schema and returns their combined output
+// When every output is a map[string]any, as for object schemas, the maps are merged with later schemas winning;
+// otherwise the output of the first schema is returned
+func (s *IntersectionSchema) parse(ctx context.Context, value any, path []any) (any, *ValidationErrors) {
+	if value == nil || len(s.schemas) == 0 {
+		if errs := s.ValidateCtx(ctx, value, path); errs.HasErrors() {
+			return nil, errs
+		}
+		return value, nil
+	}
+
+	var errors ValidationErrors
+	outputs := make([]any, 0, len(s.schemas))
+	for _, schema := range s.schemas {
+		output, schemaErr := parseValue(ctx, schema, value, path)
+		if schemaErr != nil {
+			errors.Errors = append(errors.Errors, schemaErr.Errors...)
+		}
+		if !schemaErr.HasErrors() {
+			outputs = append(outputs, output)
+		}
+	}
+	if errors.HasErrors() {
+		return nil, errors.orNil()
+	}
+
+	// Refinements see the input value, as they do in ValidateCtx
+	s.applyRefinements(value, path, &errors)
+	s.applyAsyncRefinements(ctx, value, path, &errors)
+	s.applySuperRefinements(ctx, value, path, &errors)
+	if errors.HasErrors() {
+		return nil, errors.orNil()
+	}
+	return mergeOutputs(outputs), errors.orNil()
+}
+
+// mergeOutputs merges outputs into one map if they are all map[string]any, and returns the first output otherwise
+func mergeOutputs(outputs []any) any {
+	merged := make(map[string]any)
+	for _, output := range outputs {
+		m, ok := output.(map[string]any)
+		if !ok {
+			return outputs[0]
+		}
+		maps.Copy(merged, m)
+	}
+	return merged
+}
+
+// Title sets a short human-readable title used in generated documentation
+func (s *IntersectionSchema) Title(title string) *IntersectionSchema {
+	s.annotations.Title = title
+	return s
+}
+
+// Describe sets a description used in generated documentation
+// It does not affect validation
+func (s *IntersectionSchema) Describe(description string) *IntersectionSchema {
+	s.annotations.Description = description
+	return s
+}
+
+// Example adds an example value used in generated documentation
+func (s *IntersectionSchema) Example(example any) *IntersectionSchema {
+	s.annotations.Examples = append(s.annotations.Examples, example)
+	return s
+}
+
+// Clone returns an independent copy of the schema
+// Constraints, refinements, custom errors and nested schemas are copied, so changing the clone does not affect s
+func (s *IntersectionSchema) Clone() *IntersectionSchema {
+	c := *s
+	c.BaseSchema = s.BaseSchema.clone()
+	c.schemas = make([]Schema, len(s.schemas))
+	for i, schema := range s.schemas {
+		c.schemas[i] = CloneSchema(schema)
+	}
+	return &c
+}
+
+// CustomError sets a custom error message for a specific error code
+func (s *IntersectionSchema) CustomError(code, message string) *IntersectionSchema {
+	if s.BaseSchema.customErrors == nil {
+		s.BaseSchema.customErrors = make(map[string]string)
+	}
+	s.BaseSchema.customErrors[code] = message
+	return s
+}
+
+// SetErrorFormatter sets a custom error formatter function
+func (s *IntersectionSchema) SetErrorFormatter(formatter CustomErrorFunc) *IntersectionSchema {
+	s.BaseSchema.errorFormatter = formatter
+	return s
+}
+
+// Type returns the schema type
+func (s *IntersectionSchema) Type() string {
+	return "intersection"
+}
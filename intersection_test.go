@@ -0,0 +1,116 @@
+package gozod
+
+import (
+	"testing"
+)
+
+func TestIntersection_AllMustMatch(t *testing.T) {
+	schema := Intersection(String().Min(3), String().Max(5))
+
+	if err := schema.Validate("abcd", nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+	if schema.Type() != "intersection" {
+		t.Errorf("Expected type 'intersection', got %q", schema.Type())
+	}
+
+	err := schema.Validate("abcdef", nil)
+	if err == nil || len(err.Errors) != 1 || err.Errors[0].Code != ErrCodeTooBig {
+		t.Errorf("Expected too_big error, got: %v", err)
+	}
+
+	// Errors of every schema are concatenated
+	err = Intersection(String().Email(), String().Min(20)).Validate("nope", nil)
+	if err == nil || len(err.Errors) != 2 {
+		t.Errorf("Expected 2 errors, got: %v", err)
+	}
+
+	if err := schema.Validate(nil, nil); err == nil || err.Errors[0].Code != ErrCodeRequired {
+		t.Errorf("Expected required error, got: %v", err)
+	}
+}
+
+func TestSchema_OrAnd(t *testing.T) {
+	idSchema := String().Or(Int())
+	for _, value := range []any{"abc", 42} {
+		if err := idSchema.Validate(value, nil); err != nil {
+			t.Errorf("Expected %v to match, got: %v", value, err)
+		}
+	}
+	if err := idSchema.Validate(true, nil); err == nil || err.Errors[0].Code != ErrCodeInvalidUnion {
+		t.Errorf("Expected invalid_union error, got: %v", err)
+	}
+
+	// Chained Or calls build a flat union
+	flat := String().Or(Int()).Or(Bool())
+	if len(flat.options) != 3 {
+		t.Errorf("Expected 3 options, got %d", len(flat.options))
+	}
+
+	rangeSchema := Int().Min(1).And(Int().Max(10)).And(Int().MultipleOf(2))
+	if len(rangeSchema.schemas) != 3 {
+		t.Errorf("Expected 3 schemas, got %d", len(rangeSchema.schemas))
+	}
+	if err := rangeSchema.Validate(4, nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+	err := rangeSchema.Validate(11, nil)
+	if err == nil || len(err.Errors) != 2 {
+		t.Errorf("Expected too_big and not_multiple_of errors, got: %v", err)
+	}
+}
+
+func TestSchema_OrAnd_SharedSlice(t *testing.T) {
+	// Spare capacity in the caller's slice must not let one schema's Or/And overwrite another's
+	options := make([]Schema, 2, 3)
+	options[0], options[1] = String(), Int()
+	first := Union(options...).Or(Bool())
+	Union(options...).Or(Null())
+	if err := first.Validate(true, nil); err != nil {
+		t.Errorf("Expected the first union to keep its Bool option, got: %v", err)
+	}
+
+	schemas := make([]Schema, 1, 2)
+	schemas[0] = Int()
+	bounded := Intersection(schemas...).And(Int().Max(10))
+	Intersection(schemas...).And(Int().Min(100))
+	if err := bounded.Validate(5, nil); err != nil {
+		t.Errorf("Expected the first intersection to keep its Max, got: %v", err)
+	}
+}
+
+func TestIntersection_Objects(t *testing.T) {
+	person := Map(map[string]Schema{"name": String().Min(1)})
+	employee := Map(map[string]Schema{"employeeId": Int().Positive()})
+	schema := Intersection(person, employee)
+
+	if err := schema.Validate(map[string]any{"name": "Ann", "employeeId": 7}, nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+
+	// Both field sets are validated and the errors concatenated
+	err := schema.Validate(map[string]any{"name": "", "employeeId": -1}, nil)
+	if err == nil || len(err.Errors) != 2 {
+		t.Fatalf("Expected 2 errors, got: %v", err)
+	}
+	if !PathEqual(err.Errors[0].Path, []any{"name"}) || !PathEqual(err.Errors[1].Path, []any{"employeeId"}) {
+		t.Errorf("Expected errors at name then employeeId, got: %v", err)
+	}
+
+	// A strict object rejects the keys declared only by the other schema
+	strict := Intersection(Map(map[string]Schema{"name": String()}).Strict(), employee)
+	err = strict.Validate(map[string]any{"name": "Ann", "employeeId": 7}, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeUnrecognizedKeys {
+		t.Errorf("Expected unrecognized_keys error, got: %v", err)
+	}
+
+	parsed, errs := Parse[map[string]any](schema, map[string]any{"name": "Ann", "employeeId": 7.0})
+	if errs != nil || parsed["name"] != "Ann" || len(parsed) != 2 {
+		t.Errorf("Expected merged output, got %v (%v)", parsed, errs)
+	}
+
+	sample := GenerateSample(schema)
+	if err := schema.Validate(sample, nil); err != nil {
+		t.Errorf("Expected a valid sample, got %v: %v", sample, err)
+	}
+}
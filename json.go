@@ -0,0 +1,97 @@
+package gozod
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ParseJSON decodes data as JSON, validates the result against s and returns the output as T
+// JSON numbers are decoded as int64 when they are integers and as float64 otherwise,
+// so Int() fields validate as expected; Float() fields accept the whole numbers too,
+// as they would after json.Unmarshal
+// Integers above math.MaxInt64 are decoded as uint64, and integers beyond uint64 or
+// numbers outside the float64 range stay json.Number, which BigInt() accepts
+// Malformed JSON yields a single ErrCodeInvalidJSON error
+func ParseJSON[T any](s Schema, data []byte) (T, *ValidationErrors) {
+	var zero T
+	value, err := decodeJSON(data)
+	if err != nil {
+		errs := &ValidationErrors{}
+		errs.Add(nil, ErrCodeInvalidJSON, fmt.Sprintf("Invalid JSON: %v", err))
+		return zero, errs
+	}
+	return parseAs[T](withJSONInput(context.Background()), s, value)
+}
+
+// jsonInputKey marks a context used to validate a value decoded by ParseJSON
+type jsonInputKey struct{}
+
+// withJSONInput returns a context recording that the value being validated was decoded from JSON
+func withJSONInput(ctx context.Context) context.Context {
+	return context.WithValue(ctx, jsonInputKey{}, true)
+}
+
+// isJSONInput reports whether ctx was created by withJSONInput
+// JSON does not tell whole floats from integers, so Float accepts the int64 values decoding produces
+func isJSONInput(ctx context.Context) bool {
+	fromJSON, _ := ctx.Value(jsonInputKey{}).(bool)
+	return fromJSON
+}
+
+// ParseJSON decodes data as a JSON object and validates it against the schema
+func (s *MapSchema) ParseJSON(data []byte) (map[string]any, *ValidationErrors) {
+	return ParseJSON[map[string]any](s, data)
+}
+
+// decodeJSON decodes a single JSON value, rejecting trailing data
+func decodeJSON(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, fmt.Errorf("unexpected data after top-level value")
+	}
+	return normalizeJSONNumbers(value), nil
+}
+
+// normalizeJSONNumbers replaces json.Number values with int64, uint64 or float64
+// Integers too large for uint64 and numbers outside the float64 range stay json.Number so no precision is lost
+func normalizeJSONNumbers(value any) any {
+	switch v := value.(type) {
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n
+		}
+		if n, err := strconv.ParseUint(v.String(), 10, 64); err == nil {
+			return n
+		}
+		if !strings.ContainsAny(v.String(), ".eE") {
+			return v
+		}
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+		return v
+	case map[string]any:
+		for key, item := range v {
+			v[key] = normalizeJSONNumbers(item)
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = normalizeJSONNumbers(item)
+		}
+		return v
+	default:
+		return value
+	}
+}
@@ -0,0 +1,115 @@
+package gozod
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestParseJSON(t *testing.T) {
+	schema := Map(map[string]Schema{
+		"name":  String().Min(2),
+		"age":   Int().Min(18),
+		"score": Float().AcceptInt(),
+		"tags":  Array(String()),
+	})
+
+	obj, errs := schema.ParseJSON([]byte(`{"name": "Ann", "age": 30, "score": 9.5, "tags": ["a"]}`))
+	if errs != nil {
+		t.Fatalf("Expected no errors, got: %v", errs)
+	}
+	if obj["name"] != "Ann" || obj["age"] != int64(30) || obj["score"] != 9.5 {
+		t.Errorf("Unexpected result: %v", obj)
+	}
+
+	// Whole numbers still satisfy a float field with AcceptInt
+	if _, errs := schema.ParseJSON([]byte(`{"name": "Ann", "age": 30, "score": 9, "tags": []}`)); errs != nil {
+		t.Errorf("Expected no errors, got: %v", errs)
+	}
+
+	// Validation errors keep their paths
+	_, errs = schema.ParseJSON([]byte(`{"name": "Ann", "age": 17.5, "score": 1, "tags": [1]}`))
+	if errs == nil || len(errs.Errors) != 2 {
+		t.Fatalf("Expected 2 errors, got: %v", errs)
+	}
+}
+
+func TestParseJSON_InvalidJSON(t *testing.T) {
+	for _, data := range []string{`{"name": `, `{"a": 1} {"b": 2}`, ``, `nope`} {
+		_, errs := ParseJSON[map[string]any](Map(map[string]Schema{}), []byte(data))
+		if errs == nil || errs.Errors[0].Code != ErrCodeInvalidJSON {
+			t.Errorf("Expected %s error for %q, got: %v", ErrCodeInvalidJSON, data, errs)
+		}
+	}
+}
+
+func TestParseJSON_Generic(t *testing.T) {
+	n, errs := ParseJSON[int64](Int().Positive(), []byte(`42`))
+	if errs != nil || n != 42 {
+		t.Errorf("Expected 42, got %v (%v)", n, errs)
+	}
+
+	// A JSON array decodes to []any
+	items, errs := ParseJSON[[]any](Array(String()).Min(1), []byte(`["a", "b"]`))
+	if errs != nil || len(items) != 2 {
+		t.Errorf("Expected two items, got %v (%v)", items, errs)
+	}
+
+	// A type mismatch is reported after validation passes
+	_, errs = ParseJSON[string](Validated(), []byte(`1`))
+	if errs == nil || errs.Errors[0].Code != ErrCodeInvalidType {
+		t.Errorf("Expected %s error, got: %v", ErrCodeInvalidType, errs)
+	}
+}
+
+func TestParseJSON_LargeNumbers(t *testing.T) {
+	// Numbers are never turned into strings
+	if _, errs := ParseJSON[string](String(), []byte(`1e400`)); errs == nil {
+		t.Error("Expected String to reject 1e400")
+	}
+
+	n, errs := ParseJSON[uint64](Int().Unsigned(), []byte(`18446744073709551615`))
+	if errs != nil {
+		t.Fatalf("Expected no errors, got: %v", errs)
+	}
+	if n != math.MaxUint64 {
+		t.Errorf("Expected MaxUint64, got: %d", n)
+	}
+
+	b, errs := ParseJSON[*big.Int](BigInt(), []byte(`18446744073709551616`))
+	if errs != nil {
+		t.Fatalf("Expected no errors, got: %v", errs)
+	}
+	if b.String() != "18446744073709551616" {
+		t.Errorf("Expected 18446744073709551616, got: %s", b)
+	}
+
+	if _, errs := ParseJSON[float64](Float(), []byte(`18446744073709551616`)); errs != nil {
+		t.Errorf("Expected Float to accept a large integer, got: %v", errs)
+	}
+}
+
+func TestParseJSON_WholeNumberFloat(t *testing.T) {
+	schema := Map(map[string]Schema{
+		"price": Float().Min(1),
+		"items": Array(Float()),
+	})
+
+	// json.Unmarshal followed by Validate accepts whole numbers for Float, so ParseJSON does too
+	obj, errs := schema.ParseJSON([]byte(`{"price": 10, "items": [1, 2.5]}`))
+	if errs != nil {
+		t.Fatalf("Expected no errors, got: %v", errs)
+	}
+	if obj["price"] != float64(10) {
+		t.Errorf("Expected the price as float64, got: %T", obj["price"])
+	}
+
+	if _, errs := schema.ParseJSON([]byte(`{"price": 0, "items": []}`)); errs == nil || errs.Errors[0].Code != ErrCodeTooSmall {
+		t.Errorf("Expected %s error, got: %v", ErrCodeTooSmall, errs)
+	}
+
+	// Outside ParseJSON, Float still rejects Go integers unless AcceptInt is set
+	if errs := Float().Validate(int64(10), nil); errs == nil {
+		t.Error("Expected Float to reject an int64")
+	}
+}
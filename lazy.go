@@ -0,0 +1,64 @@
+package gozod
+
+import (
+	"context"
+	"sync"
+)
+
+// LazySchema defers construction of its inner schema until it is first used
+// This enables recursive and self-referential schemas (e.g. trees of comments)
+type LazySchema struct {
+	getter func() Schema
+	once   sync.Once
+	schema Schema
+}
+
+// Lazy creates a schema whose inner schema is resolved by calling getter on first use
+// The getter may reference the variable the lazy schema is assigned to
+func Lazy(getter func() Schema) *LazySchema {
+	if getter == nil {
+		panic("gozod: Lazy requires a non-nil getter")
+	}
+	return &LazySchema{getter: getter}
+}
+
+// resolve returns the inner schema, calling the getter exactly once
+// Chains of lazy schemas are collapsed; a chain that leads back to itself panics
+// instead of recursing forever
+func (s *LazySchema) resolve() Schema {
+	s.once.Do(func() {
+		seen := map[*LazySchema]bool{s: true}
+		inner := s.getter()
+		for {
+			lazy, ok := inner.(*LazySchema)
+			if !ok {
+				break
+			}
+			if seen[lazy] {
+				panic("gozod: Lazy schema resolves to itself")
+			}
+			seen[lazy] = true
+			inner = lazy.getter()
+		}
+		if inner == nil {
+			panic("gozod: Lazy getter returned a nil schema")
+		}
+		s.schema = inner
+	})
+	return s.schema
+}
+
+// Validate validates a value against the resolved schema
+func (s *LazySchema) Validate(value any, path []any) *ValidationErrors {
+	return s.ValidateCtx(context.Background(), value, path)
+}
+
+// ValidateCtx validates a value against the resolved schema using ctx
+func (s *LazySchema) ValidateCtx(ctx context.Context, value any, path []any) *ValidationErrors {
+	return s.resolve().ValidateCtx(ctx, value, path)
+}
+
+// Type returns the type of the resolved schema
+func (s *LazySchema) Type() string {
+	return s.resolve().Type()
+}
@@ -0,0 +1,76 @@
+package gozod
+
+import (
+	"testing"
+)
+
+func TestLazySchema_Recursive(t *testing.T) {
+	var comment Schema
+	comment = Map(map[string]Schema{
+		"text":    String().Min(1),
+		"replies": Array(Lazy(func() Schema { return comment })),
+	})
+
+	// Valid: nested replies
+	data := map[string]any{
+		"text": "root",
+		"replies": []any{
+			map[string]any{
+				"text": "child",
+				"replies": []any{
+					map[string]any{"text": "grandchild", "replies": []any{}},
+				},
+			},
+		},
+	}
+	if err := comment.Validate(data, nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+
+	// Invalid: empty text deep in the tree
+	data["replies"].([]any)[0].(map[string]any)["replies"].([]any)[0].(map[string]any)["text"] = ""
+	err := comment.Validate(data, nil)
+	if err == nil {
+		t.Fatal("Expected error for empty nested text")
+	}
+	expected := []any{"replies", 0, "replies", 0, "text"}
+	if !PathEqual(err.Errors[0].Path, expected) {
+		t.Errorf("Expected path %v, got %v", expected, err.Errors[0].Path)
+	}
+}
+
+func TestLazySchema_ResolvesOnce(t *testing.T) {
+	calls := 0
+	schema := Lazy(func() Schema {
+		calls++
+		return String()
+	})
+	if calls != 0 {
+		t.Errorf("Expected getter not to run at construction, ran %d time(s)", calls)
+	}
+
+	_ = schema.Validate("a", nil)
+	_ = schema.Validate("b", nil)
+	if calls != 1 {
+		t.Errorf("Expected getter to run once, ran %d time(s)", calls)
+	}
+}
+
+func TestLazySchema_Type(t *testing.T) {
+	schema := Lazy(func() Schema { return Int() })
+	if schema.Type() != "int" {
+		t.Errorf("Expected type 'int', got '%s'", schema.Type())
+	}
+}
+
+func TestLazySchema_SelfReferencePanics(t *testing.T) {
+	var schema *LazySchema
+	schema = Lazy(func() Schema { return schema })
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for lazy schema resolving to itself")
+		}
+	}()
+	_ = schema.Validate("value", nil)
+}
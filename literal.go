@@ -0,0 +1,124 @@
+package gozod
+
+import (
+	"context"
+	"fmt"
+)
+
+// LiteralSchema validates that a value equals one exact literal
+type LiteralSchema struct {
+	BaseSchema
+	value any
+}
+
+// Literal creates a schema that only accepts the given value
+// Numbers compare by value regardless of their Go type, other values with reflect.DeepEqual
+func Literal(value any) *LiteralSchema {
+	return &LiteralSchema{
+		BaseSchema: BaseSchema{required: true},
+		value:      value,
+	}
+}
+
+// Nilable allows null values
+func (s *LiteralSchema) Nilable() *LiteralSchema {
+	s.nilable = true
+	return s
+}
+
+// Optional allows missing values without declaring null a valid value
+// Validation accepts nil as with Nilable; the difference shows in IsOptional and IsNilable
+func (s *LiteralSchema) Optional() *LiteralSchema {
+	s.required = false
+	return s
+}
+
+// Value returns the literal the schema matches
+func (s *LiteralSchema) Value() any {
+	return s.value
+}
+
+// Or returns a union that accepts values matching either s or other
+func (s *LiteralSchema) Or(other Schema) *UnionSchema {
+	return Union(s, other)
+}
+
+// And returns an intersection that requires values to match both s and other
+func (s *LiteralSchema) And(other Schema) *IntersectionSchema {
+	return Intersection(s, other)
+}
+
+// Validate validates a value against the literal schema
+func (s *LiteralSchema) Validate(value any, path []any) *ValidationErrors {
+	return s.ValidateCtx(context.Background(), value, path)
+}
+
+// ValidateCtx validates a value using ctx for cancellation and request scoping
+func (s *LiteralSchema) ValidateCtx(ctx context.Context, value any, path []any) *ValidationErrors {
+	// Errors are collected in a local value and only escape to the heap when validation fails
+	var errors ValidationErrors
+
+	// Handle nil/nilable
+	if value == nil {
+		if !s.allowsNil() {
+			msg := s.getErrorMessage(path, ErrCodeRequired, "Required")
+			errors.Add(path, ErrCodeRequired, msg)
+		}
+		return errors.orNil()
+	}
+
+	if !valuesEqual(value, s.value) {
+		meta := map[string]any{"expected": s.value}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeInvalidLiteral, fmt.Sprintf("Expected literal %#v, got %#v", s.value, value), meta)
+		errors.AddWithMeta(path, ErrCodeInvalidLiteral, msg, meta)
+	}
+
+	return errors.orNil()
+}
+
+// Title sets a short human-readable title used in generated documentation
+func (s *LiteralSchema) Title(title string) *LiteralSchema {
+	s.annotations.Title = title
+	return s
+}
+
+// Describe sets a description used in generated documentation
+// It does not affect validation
+func (s *LiteralSchema) Describe(description string) *LiteralSchema {
+	s.annotations.Description = description
+	return s
+}
+
+// Example adds an example value used in generated documentation
+func (s *LiteralSchema) Example(example any) *LiteralSchema {
+	s.annotations.Examples = append(s.annotations.Examples, example)
+	return s
+}
+
+// Clone returns an independent copy of the schema
+// Constraints, refinements and custom errors are copied, so changing the clone does not affect s
+func (s *LiteralSchema) Clone() *LiteralSchema {
+	c := *s
+	c.BaseSchema = s.BaseSchema.clone()
+	return &c
+}
+
+// CustomError sets a custom error message for a specific error code
+func (s *LiteralSchema) CustomError(code, message string) *LiteralSchema {
+	if s.BaseSchema.customErrors == nil {
+		s.BaseSchema.customErrors = make(map[string]string)
+	}
+	s.BaseSchema.customErrors[code] = message
+	return s
+}
+
+// SetErrorFormatter sets a custom error formatter function
+func (s *LiteralSchema) SetErrorFormatter(formatter CustomErrorFunc) *LiteralSchema {
+	s.BaseSchema.errorFormatter = formatter
+	return s
+}
+
+// Type returns the schema type
+func (s *LiteralSchema) Type() string {
+	return "literal"
+}
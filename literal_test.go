@@ -0,0 +1,80 @@
+package gozod
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLiteralSchema_String(t *testing.T) {
+	schema := Literal("user")
+
+	if err := schema.Validate("user", nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+
+	err := schema.Validate("admin", nil)
+	if err == nil {
+		t.Fatal("Expected error for different literal")
+	}
+	if err.Errors[0].Code != ErrCodeInvalidLiteral {
+		t.Errorf("Expected error code %s, got %s", ErrCodeInvalidLiteral, err.Errors[0].Code)
+	}
+	if err.Errors[0].Message != `Expected literal "user", got "admin"` {
+		t.Errorf("Unexpected message: %s", err.Errors[0].Message)
+	}
+	if err.Errors[0].Meta["expected"] != "user" {
+		t.Errorf("Expected Meta expected 'user', got %v", err.Errors[0].Meta["expected"])
+	}
+}
+
+func TestLiteralSchema_Number(t *testing.T) {
+	schema := Literal(2)
+
+	// Numbers match across Go types
+	if err := schema.Validate(int64(2), nil); err != nil {
+		t.Errorf("Expected no errors for int64, got: %v", err)
+	}
+	if err := schema.Validate(2.0, nil); err != nil {
+		t.Errorf("Expected no errors for float64, got: %v", err)
+	}
+	if err := schema.Validate(3, nil); err == nil {
+		t.Error("Expected error for different number")
+	}
+	if err := schema.Validate("2", nil); err == nil {
+		t.Error("Expected error for string")
+	}
+	if err := schema.Validate(math.NaN(), nil); err == nil {
+		t.Error("Expected error for NaN")
+	}
+}
+
+func TestLiteralSchema_InMap(t *testing.T) {
+	schema := Map(map[string]Schema{
+		"version": Literal(2),
+		"type":    Literal("user"),
+	})
+
+	if err := schema.Validate(map[string]any{"version": 2, "type": "user"}, nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+
+	err := schema.Validate(map[string]any{"version": 1, "type": "user"}, nil)
+	if err == nil || !PathEqual(err.Errors[0].Path, []any{"version"}) {
+		t.Errorf("Expected error at [version], got: %v", err)
+	}
+}
+
+func TestLiteralSchema_Nil(t *testing.T) {
+	if err := Literal("x").Validate(nil, nil); err == nil || err.Errors[0].Code != ErrCodeRequired {
+		t.Errorf("Expected %s error, got: %v", ErrCodeRequired, err)
+	}
+	if err := Literal("x").Nilable().Validate(nil, nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+}
+
+func TestLiteralSchema_Type(t *testing.T) {
+	if Literal("x").Type() != "literal" {
+		t.Errorf("Expected type 'literal', got '%s'", Literal("x").Type())
+	}
+}
@@ -1,22 +1,36 @@
 package gozod
 
 import (
+	"context"
 	"fmt"
+	"maps"
 	"reflect"
+	"slices"
 )
 
 // MapSchema validates object/map values
 type MapSchema struct {
 	BaseSchema
-	shape  map[string]Schema
-	strict bool // If true, rejects unknown keys (default: false, allows extra keys)
+	shape     map[string]Schema
+	keys      []string        // Shape keys in sorted order, so fields are validated deterministically
+	strict    bool            // If true, rejects unknown keys (default: false, allows extra keys)
+	warnOnly  bool            // With strict, unknown keys are reported as warnings, set by StrictWarn
+	strip     bool            // If true, Parse leaves unknown keys out of the cleaned object without errors
+	optional  map[string]bool // Keys that may be missing or nil, set by Partial
+	catchall  Schema          // If set, validates unknown keys instead of allowing or rejecting them
+	keySchema Schema          // If set, validates every key of the input, set by KeySchema
+	maxKeys   *int            // Hard ceiling checked before any key is touched, set by MaxKeys
 }
 
 // Map creates a new object/map schema
+// Fields are validated in sorted key order, so errors come out in the same order on every run
+// The shape is copied, so later changes to the caller's map do not affect the schema
 func Map(shape map[string]Schema) *MapSchema {
+	shape = maps.Clone(shape)
 	return &MapSchema{
 		BaseSchema: BaseSchema{required: true},
 		shape:      shape,
+		keys:       slices.Sorted(maps.Keys(shape)),
 		strict:     false, // Extra keys allowed by default
 	}
 }
@@ -27,18 +41,139 @@ func (s *MapSchema) Nilable() *MapSchema {
 	return s
 }
 
+// Optional allows missing values without declaring null a valid value
+// Validation accepts nil as with Nilable; the difference shows in IsOptional and IsNilable
+func (s *MapSchema) Optional() *MapSchema {
+	s.required = false
+	return s
+}
+
 // Strict rejects unknown keys
 func (s *MapSchema) Strict() *MapSchema {
 	s.strict = true
+	s.warnOnly = false
+	s.strip = false
+	return s
+}
+
+// Strip accepts unknown keys but leaves them out of the object returned by Parse,
+// e.g. to prevent mass assignment of fields a client should not set
+// Validate is unaffected; it replaces Strict and StrictWarn, while Catchall still takes precedence
+func (s *MapSchema) Strip() *MapSchema {
+	s.strip = true
+	s.strict = false
+	s.warnOnly = false
+	return s
+}
+
+// StrictWarn reports unknown keys as warnings instead of errors
+// The value stays valid, so unexpected keys can be logged (e.g. during a migration) without failing;
+// use HasErrors or WithoutWarnings on the result to tell warnings from errors
+// Parse leaves unknown keys out of the cleaned object
+func (s *MapSchema) StrictWarn() *MapSchema {
+	s.strict = true
+	s.warnOnly = true
+	s.strip = false
 	return s
 }
 
+// Catchall validates the values of unknown keys against schema
+// It takes precedence over Strict
+func (s *MapSchema) Catchall(schema Schema) *MapSchema {
+	s.catchall = schema
+	return s
+}
+
+// KeySchema validates every key of the input, known or unknown, against schema
+// Key errors are reported at the key's path with Meta["key"] set to the key, which tells them
+// apart from errors for the value; Parse does not rename keys even if schema transforms them
+func (s *MapSchema) KeySchema(schema Schema) *MapSchema {
+	s.keySchema = schema
+	return s
+}
+
+// MaxKeys sets a hard ceiling on the number of keys, to protect against oversized untrusted input
+// It is checked before the keys are copied or validated, and exceeding it
+// fails with a single ErrCodeResourceLimit error and no other errors
+func (s *MapSchema) MaxKeys(n int) *MapSchema {
+	s.maxKeys = &n
+	return s
+}
+
+// Pick returns a new schema with only the given keys of the shape
+// It panics if a key is not in the shape
+func (s *MapSchema) Pick(keys ...string) *MapSchema {
+	c := s.Clone()
+	c.shape = pickShape("Pick", c.shape, keys)
+	c.keys = slices.Sorted(maps.Keys(c.shape))
+	return c
+}
+
+// Omit returns a new schema without the given keys of the shape
+// It panics if a key is not in the shape
+func (s *MapSchema) Omit(keys ...string) *MapSchema {
+	c := s.Clone()
+	c.shape = omitShape(c.shape, keys)
+	c.keys = slices.Sorted(maps.Keys(c.shape))
+	return c
+}
+
+// Extend returns a new schema with shape added to the existing shape
+// Keys already in the shape are replaced
+func (s *MapSchema) Extend(shape Shape) *MapSchema {
+	c := s.Clone()
+	if c.shape == nil {
+		c.shape = make(map[string]Schema, len(shape))
+	}
+	for key, schema := range shape {
+		c.shape[key] = schema
+		delete(c.optional, key)
+	}
+	c.keys = slices.Sorted(maps.Keys(c.shape))
+	return c
+}
+
+// Partial returns a new schema where the given keys (all keys if none are given) may be missing or nil
+// It panics if a key is not in the shape
+func (s *MapSchema) Partial(keys ...string) *MapSchema {
+	c := s.Clone()
+	c.optional = partialKeys(c.shape, c.optional, keys)
+	return c
+}
+
+// DeepPartial returns a new schema where every key may be missing or nil, recursively
+// Nested object schemas, including the elements of arrays, are relaxed too; Lazy schemas are not
+func (s *MapSchema) DeepPartial() *MapSchema {
+	c := s.Clone()
+	deepPartial(c)
+	return c
+}
+
+// Fields returns a copy of the shape, keyed by field name
+// Modifying the returned map does not affect the schema
+func (s *MapSchema) Fields() Shape {
+	return Shape(maps.Clone(s.shape))
+}
+
+// Keys returns the field names of the shape in sorted order
+func (s *MapSchema) Keys() []string {
+	return slices.Clone(s.keys)
+}
+
 // Refine adds a custom validation function
 // The function receives the value and returns (isValid, errorMessage)
 // If isValid is false, validation fails with the provided errorMessage
 // If errorMessage is empty, a default message will be used
-func (s *MapSchema) Refine(validator RefineFunc) *MapSchema {
-	s.BaseSchema.addRefinement(validator)
+// Options such as OnlyIfValid and AbortOnFailure control when it runs
+func (s *MapSchema) Refine(validator RefineFunc, opts ...RefineOption) *MapSchema {
+	s.BaseSchema.addRefinement(validator, opts...)
+	return s
+}
+
+// UseRefinement attaches a refinement registered with RegisterRefinement
+// It panics if no refinement is registered under name
+func (s *MapSchema) UseRefinement(name string) *MapSchema {
+	s.BaseSchema.useRefinement(name)
 	return s
 }
 
@@ -50,75 +185,295 @@ func (s *MapSchema) SuperRefine(validator SuperRefineFunc) *MapSchema {
 	return s
 }
 
+// AsyncRefine adds a context-aware validation function
+// The function receives the context passed to ValidateCtx and is awaited before validation returns
+// Use it for expensive checks (e.g. database lookups) that need cancellation or deadlines
+func (s *MapSchema) AsyncRefine(validator AsyncRefineFunc) *MapSchema {
+	s.BaseSchema.addAsyncRefinement(validator)
+	return s
+}
+
+// RefineWithParent adds a validation function that also receives the enclosing object
+// It runs when the schema validates a field of a Map or Struct and the field itself is valid,
+// including a nil value on a Nilable field, which makes it suitable for conditionally required fields
+func (s *MapSchema) RefineWithParent(validator ParentRefineFunc) *MapSchema {
+	s.BaseSchema.addParentRefinement(validator)
+	return s
+}
+
+// Or returns a union that accepts values matching either s or other
+func (s *MapSchema) Or(other Schema) *UnionSchema {
+	return Union(s, other)
+}
+
+// And returns an intersection that requires values to match both s and other
+func (s *MapSchema) And(other Schema) *IntersectionSchema {
+	return Intersection(s, other)
+}
+
 // Validate validates a value against the object schema
 func (s *MapSchema) Validate(value any, path []any) *ValidationErrors {
-	errors := &ValidationErrors{}
+	return s.ValidateCtx(context.Background(), value, path)
+}
+
+// ValidateCtx validates a value using ctx for cancellation and request scoping
+func (s *MapSchema) ValidateCtx(ctx context.Context, value any, path []any) *ValidationErrors {
+	_, errs := s.validate(ctx, value, path, false)
+	return errs
+}
+
+// Parse validates value and returns the cleaned object
+// Field values are the outputs of their schemas (e.g. coerced strings and numbers), unknown keys
+// hold the output of the Catchall schema (or are left out with Strip), and optional keys that are missing stay missing
+// A valid nil value (with Nilable) yields a nil map
+func (s *MapSchema) Parse(value any) (map[string]any, *ValidationErrors) {
+	return Parse[map[string]any](s, value)
+}
+
+// parse validates value and returns the cleaned object as a new map[string]any
+func (s *MapSchema) parse(ctx context.Context, value any, path []any) (any, *ValidationErrors) {
+	output, errs := s.validate(ctx, value, path, true)
+	if errs.HasErrors() || output == nil {
+		return nil, errs
+	}
+	return output, errs
+}
+
+// validate validates value and, if clean is set, builds the cleaned object
+// Without clean, field schemas are only validated and no output map is allocated
+func (s *MapSchema) validate(ctx context.Context, value any, path []any, clean bool) (map[string]any, *ValidationErrors) {
+	// Errors are collected in a local value and only escape to the heap when validation fails
+	var errors ValidationErrors
 
 	// Handle nil/nilable
 	// Only nilable allows explicit nil values
 	if value == nil {
-		if !s.nilable {
+		if !s.allowsNil() {
 			msg := s.getErrorMessage(path, ErrCodeRequired, "Required")
 			errors.Add(path, ErrCodeRequired, msg)
-			return errors
+			return nil, errors.orNil()
 		}
-		return nil
+		return nil, nil
 	}
 
 	// Convert to map[string]any
-	var obj map[string]any
-	val := reflect.ValueOf(value)
+	// The common map[string]any case is used as is; other map types are copied via reflection
+	// MaxKeys is checked before any copying
+	obj, ok := value.(map[string]any)
+	if ok && s.exceedsMaxKeys(len(obj), path, &errors) {
+		return nil, errors.orNil()
+	}
+	if !ok {
+		val := reflect.ValueOf(value)
+
+		if val.Kind() != reflect.Map {
+			msg := s.getErrorMessage(path, ErrCodeInvalidType, fmt.Sprintf("Expected map, got %T", value))
+			errors.Add(path, ErrCodeInvalidType, msg)
+			return nil, errors.orNil()
+		}
+
+		// JSON objects always have string keys, so reject maps keyed by anything else
+		// rather than silently stringifying keys like map[int]any
+		if val.Type().Key().Kind() != reflect.String {
+			msg := s.getErrorMessage(path, ErrCodeInvalidType, fmt.Sprintf("Object keys must be strings, got %T", value))
+			errors.Add(path, ErrCodeInvalidType, msg)
+			return nil, errors.orNil()
+		}
+
+		if s.exceedsMaxKeys(val.Len(), path, &errors) {
+			return nil, errors.orNil()
+		}
+
+		obj = make(map[string]any, val.Len())
+		for _, key := range val.MapKeys() {
+			obj[key.String()] = val.MapIndex(key).Interface()
+		}
+	}
+
+	var output map[string]any
+	if clean {
+		output = make(map[string]any, len(obj))
+	}
+
+	// Record the top-level value for nested When schemas
+	ctx = withRoot(ctx, value)
 
-	if val.Kind() != reflect.Map {
-		msg := s.getErrorMessage(path, ErrCodeInvalidType, fmt.Sprintf("Expected map, got %T", value))
-		errors.Add(path, ErrCodeInvalidType, msg)
-		return errors
+	// Stop at the nesting depth limit instead of descending into the contents
+	ctx, ok = s.enterNested(ctx, path, &errors)
+	if !ok {
+		return nil, errors.orNil()
 	}
 
-	obj = make(map[string]any)
-	for _, key := range val.MapKeys() {
-		keyStr := fmt.Sprintf("%v", key.Interface())
-		obj[keyStr] = val.MapIndex(key).Interface()
+	// Validate the keys themselves, in sorted order
+	if s.keySchema != nil {
+		s.validateKeys(ctx, obj, path, &errors)
 	}
 
 	// Validate each field in the shape
-	for fieldName, schema := range s.shape {
+	for _, fieldName := range s.keys {
+		schema := s.shape[fieldName]
 		fieldPath := PathAppend(path, fieldName)
 
 		fieldValue, exists := obj[fieldName]
+		if fieldValue == nil && s.optional[fieldName] {
+			continue
+		}
 
 		// If field is missing, pass nil to validation (will fail if required, pass if nilable)
+		// The context records that the key was absent, which lets Null() tell it apart from an explicit nil
+		fieldCtx := ctx
 		if !exists {
 			fieldValue = nil
+			fieldCtx = withAbsent(ctx)
 		}
 
-		fieldErrors := schema.Validate(fieldValue, fieldPath)
+		var fieldErrors *ValidationErrors
+		if clean {
+			var fieldOutput any
+			fieldOutput, fieldErrors = parseValue(fieldCtx, schema, fieldValue, fieldPath)
+			// A missing key is only added when its schema produced a value for it
+			if !fieldErrors.HasErrors() && (exists || fieldOutput != nil) {
+				output[fieldName] = fieldOutput
+			}
+		} else {
+			fieldErrors = schema.ValidateCtx(fieldCtx, fieldValue, fieldPath)
+		}
+		if !fieldErrors.HasErrors() {
+			// Parent-aware refinements see the sibling keys (only if the field itself passed)
+			if refiner, ok := schema.(parentRefiner); ok {
+				if refineErrors := refiner.parentRefinementErrors(fieldValue, obj, fieldPath); refineErrors != nil {
+					fieldErrors = MergeErrors(fieldErrors, refineErrors)
+				}
+			}
+		}
 		if fieldErrors != nil {
 			errors.Errors = append(errors.Errors, fieldErrors.Errors...)
 		}
 	}
 
-	// Check for unknown keys if strict mode is enabled
-	if s.strict {
-		for key := range obj {
-			if _, exists := s.shape[key]; !exists {
-				keyPath := PathAppend(path, key)
-				msg := s.getErrorMessage(keyPath, ErrCodeUnrecognizedKeys, fmt.Sprintf("Unrecognized key '%s'", key))
-				errors.Add(keyPath, ErrCodeUnrecognizedKeys, msg)
+	// Validate unknown keys against the catchall schema, or reject them in strict mode
+	// They are visited in sorted order too; otherwise the cleaned object keeps them as they are unless Strip is set
+	if s.catchall != nil || s.strict {
+		for _, key := range s.unknownKeys(obj) {
+			keyPath := PathAppend(path, key)
+			if s.catchall != nil {
+				var keyErrors *ValidationErrors
+				if clean {
+					var keyOutput any
+					if keyOutput, keyErrors = parseValue(ctx, s.catchall, obj[key], keyPath); !keyErrors.HasErrors() {
+						output[key] = keyOutput
+					}
+				} else {
+					keyErrors = s.catchall.ValidateCtx(ctx, obj[key], keyPath)
+				}
+				if keyErrors != nil {
+					errors.Errors = append(errors.Errors, keyErrors.Errors...)
+				}
+				continue
+			}
+			msg := s.getErrorMessage(keyPath, ErrCodeUnrecognizedKeys, fmt.Sprintf("Unrecognized key '%s'", key))
+			if s.warnOnly {
+				errors.AddWarning(keyPath, ErrCodeUnrecognizedKeys, msg, nil)
+				continue
+			}
+			errors.Add(keyPath, ErrCodeUnrecognizedKeys, msg)
+		}
+	} else if clean && !s.strip {
+		for key, keyValue := range obj {
+			if _, known := s.shape[key]; !known {
+				output[key] = keyValue
 			}
 		}
 	}
 
 	// Apply custom refinements (only if type check passed)
-	s.applyRefinements(value, path, errors)
+	s.applyRefinements(value, path, &errors)
+
+	// Apply async refinements (only if type check passed)
+	s.applyAsyncRefinements(ctx, value, path, &errors)
 
 	// Apply super refinements (only if type check passed)
-	s.applySuperRefinements(value, path, errors)
+	s.applySuperRefinements(ctx, value, path, &errors)
 
-	if len(errors.Errors) == 0 {
-		return nil
+	if errors.HasErrors() {
+		return nil, errors.orNil()
+	}
+	return output, errors.orNil()
+}
+
+// exceedsMaxKeys reports whether count is above the MaxKeys ceiling, recording the error if so
+func (s *MapSchema) exceedsMaxKeys(count int, path []any, errors *ValidationErrors) bool {
+	if s.maxKeys == nil || count <= *s.maxKeys {
+		return false
+	}
+	meta := map[string]any{"constraint": "maxKeys", "limit": *s.maxKeys, "actual": count}
+	msg := s.getErrorMessageWithMeta(path, ErrCodeResourceLimit, fmt.Sprintf("Object has %d keys, more than the limit of %d", count, *s.maxKeys), meta)
+	errors.AddWithMeta(path, ErrCodeResourceLimit, msg, meta)
+	return true
+}
+
+// validateKeys validates each key of obj against the key schema, marking the errors with the key
+func (s *MapSchema) validateKeys(ctx context.Context, obj map[string]any, path []any, errors *ValidationErrors) {
+	for _, key := range slices.Sorted(maps.Keys(obj)) {
+		keyErrors := s.keySchema.ValidateCtx(ctx, key, PathAppend(path, key))
+		if keyErrors == nil {
+			continue
+		}
+		for _, err := range keyErrors.Errors {
+			if err.Meta == nil {
+				err.Meta = map[string]any{}
+			}
+			err.Meta["key"] = key
+			errors.Errors = append(errors.Errors, err)
+		}
+	}
+}
+
+// unknownKeys returns the keys of obj that are not in the shape, in sorted order
+func (s *MapSchema) unknownKeys(obj map[string]any) []string {
+	var unknown []string
+	for key := range obj {
+		if _, exists := s.shape[key]; !exists {
+			unknown = append(unknown, key)
+		}
+	}
+	slices.Sort(unknown)
+	return unknown
+}
+
+// Title sets a short human-readable title used in generated documentation
+func (s *MapSchema) Title(title string) *MapSchema {
+	s.annotations.Title = title
+	return s
+}
+
+// Describe sets a description used in generated documentation
+// It does not affect validation
+func (s *MapSchema) Describe(description string) *MapSchema {
+	s.annotations.Description = description
+	return s
+}
+
+// Example adds an example value used in generated documentation
+func (s *MapSchema) Example(example any) *MapSchema {
+	s.annotations.Examples = append(s.annotations.Examples, example)
+	return s
+}
+
+// Clone returns an independent copy of the schema
+// Constraints, refinements, custom errors and nested schemas are copied, so changing the clone does not affect s
+func (s *MapSchema) Clone() *MapSchema {
+	c := *s
+	c.BaseSchema = s.BaseSchema.clone()
+	c.shape = cloneShape(s.shape)
+	c.optional = maps.Clone(s.optional)
+	if s.catchall != nil {
+		c.catchall = CloneSchema(s.catchall)
+	}
+	if s.keySchema != nil {
+		c.keySchema = CloneSchema(s.keySchema)
 	}
-	return errors
+	return &c
 }
 
 // CustomError sets a custom error message for a specific error code
@@ -1,6 +1,7 @@
 package gozod
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -373,3 +374,284 @@ func TestMapSchema_ErrorPaths(t *testing.T) {
 		t.Error("Expected error path to include nested field path")
 	}
 }
+
+func TestMapSchema_RejectsNonStringKeys(t *testing.T) {
+	schema := Map(map[string]Schema{
+		"1": String(),
+	})
+
+	err := schema.Validate(map[int]string{1: "one"}, nil)
+	if err == nil {
+		t.Fatal("Expected error for map with int keys")
+	}
+	if err.Errors[0].Code != ErrCodeInvalidType {
+		t.Errorf("Expected error code %s, got %s", ErrCodeInvalidType, err.Errors[0].Code)
+	}
+	if len(err.Errors) != 1 {
+		t.Errorf("Expected a single error, got %d", len(err.Errors))
+	}
+
+	// Named string key types are still accepted
+	type key string
+	if err := schema.Validate(map[key]any{"1": "one"}, nil); err != nil {
+		t.Errorf("Expected no errors for string-kinded keys, got: %v", err)
+	}
+}
+
+func TestMapSchema_OtherMapTypes(t *testing.T) {
+	schema := Map(map[string]Schema{
+		"name": String().Min(2),
+	})
+
+	// Maps other than map[string]any go through the reflection path with the same results
+	if err := schema.Validate(map[string]string{"name": "Ann"}, nil); err != nil {
+		t.Errorf("Expected no errors for map[string]string, got: %v", err)
+	}
+	type object map[string]any
+	if err := schema.Validate(object{"name": "Ann"}, nil); err != nil {
+		t.Errorf("Expected no errors for named map type, got: %v", err)
+	}
+	err := schema.Validate(map[string]string{"name": "A"}, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeTooSmall {
+		t.Errorf("Expected %s error, got: %v", ErrCodeTooSmall, err)
+	}
+
+	// A nil map[string]any is an empty object, not a null value
+	err = schema.Validate(map[string]any(nil), nil)
+	if err == nil || err.Errors[0].Code != ErrCodeRequired || !PathEqual(err.Errors[0].Path, []any{"name"}) {
+		t.Errorf("Expected required error at name, got: %v", err)
+	}
+}
+
+func TestMapSchema_DeterministicOrder(t *testing.T) {
+	schema := Map(map[string]Schema{
+		"zeta":  String(),
+		"alpha": String(),
+		"mid":   Int(),
+		"beta":  Bool(),
+	}).Strict()
+	value := map[string]any{"extra2": 1, "extra1": 2}
+
+	expected := []string{"alpha", "beta", "mid", "zeta", "extra1", "extra2"}
+	for range 20 {
+		err := schema.Validate(value, nil)
+		if err == nil || len(err.Errors) != len(expected) {
+			t.Fatalf("Expected %d errors, got: %v", len(expected), err)
+		}
+		for i, key := range expected {
+			if err.Errors[i].Path[0] != key {
+				t.Fatalf("Expected error %d at %q, got %v", i, key, err.Errors[i].Path)
+			}
+		}
+	}
+
+	// Extended schemas keep the sorted order
+	extended := schema.Extend(Shape{"aardvark": String()})
+	if err := extended.Validate(map[string]any{}, nil); err == nil || err.Errors[0].Path[0] != "aardvark" {
+		t.Errorf("Expected the first error at aardvark, got: %v", err)
+	}
+}
+
+func TestMapSchema_Parse(t *testing.T) {
+	schema := Map(map[string]Schema{
+		"id":      String().Coerce(),
+		"age":     Int(),
+		"score":   Float().AcceptInt(),
+		"note":    String(),
+		"address": Map(map[string]Schema{"zip": String().Coerce()}).Strict(),
+	}).Partial("note").Catchall(String().Coerce())
+
+	input := map[string]any{
+		"id":      42,
+		"age":     30.0,
+		"score":   7,
+		"address": map[string]any{"zip": 1234},
+		"tag":     true,
+	}
+	output, errs := schema.Parse(input)
+	if errs != nil {
+		t.Fatalf("Expected no errors, got %v", errs)
+	}
+
+	expected := map[string]any{
+		"id":      "42",
+		"age":     30,
+		"score":   7.0,
+		"address": map[string]any{"zip": "1234"},
+		"tag":     "true",
+	}
+	if !reflect.DeepEqual(output, expected) {
+		t.Errorf("Expected %v, got %v", expected, output)
+	}
+	if input["id"] != 42 {
+		t.Error("Expected the input to be left unchanged")
+	}
+
+	// Without Catchall, unknown keys are kept as they are
+	output, _ = Map(map[string]Schema{"id": String().Coerce()}).Parse(map[string]any{"id": 1, "extra": 2})
+	if !reflect.DeepEqual(output, map[string]any{"id": "1", "extra": 2}) {
+		t.Errorf("Expected unknown key to be kept, got %v", output)
+	}
+
+	// Invalid input returns the errors and no object
+	output, errs = schema.Parse(map[string]any{"id": 1, "age": "x", "score": 1, "address": map[string]any{"zip": "1"}})
+	if output != nil || errs == nil || errs.Errors[0].Code != ErrCodeInvalidType {
+		t.Errorf("Expected an invalid_type error and nil output, got %v, %v", output, errs)
+	}
+
+	// Validate and Parse report the same errors
+	if validateErrs := schema.Validate(map[string]any{"id": 1, "age": "x", "score": 1, "address": map[string]any{"zip": "1"}}, nil); validateErrs.FormatErrors() != errs.FormatErrors() {
+		t.Errorf("Expected identical errors, got %v and %v", validateErrs, errs)
+	}
+}
+
+func TestMapSchema_StrictWarn(t *testing.T) {
+	schema := Map(map[string]Schema{
+		"name": String(),
+		"age":  Int(),
+	}).StrictWarn()
+	input := map[string]any{"name": "John", "age": 30, "legacy": true}
+
+	// Unknown keys are reported as warnings, which leave the value valid
+	errs := schema.Validate(input, nil)
+	if errs == nil || errs.HasErrors() {
+		t.Fatalf("Expected warnings only, got: %v", errs)
+	}
+	warnings := errs.Warnings()
+	if len(warnings) != 1 || warnings[0].Code != ErrCodeUnrecognizedKeys || warnings[0].Severity != SeverityWarning ||
+		PathToString(warnings[0].Path) != "legacy" {
+		t.Errorf("Expected one unrecognized key warning, got: %v", warnings)
+	}
+	if errs.WithoutWarnings() != nil {
+		t.Errorf("Expected no errors after filtering warnings, got: %v", errs.WithoutWarnings())
+	}
+	if err := Check(schema, input); err != nil {
+		t.Errorf("Expected Check to pass, got: %v", err)
+	}
+
+	// Parse returns the cleaned object without the unknown key, along with the warnings
+	output, errs := schema.Parse(input)
+	if errs.HasErrors() || !reflect.DeepEqual(output, map[string]any{"name": "John", "age": 30}) {
+		t.Errorf("Expected cleaned object, got %v, %v", output, errs)
+	}
+
+	// Real errors are still errors, with the default severity
+	errs = schema.Validate(map[string]any{"name": 1, "age": 30, "legacy": true}, nil)
+	if !errs.HasErrors() || len(errs.Errors) != 2 {
+		t.Fatalf("Expected an error and a warning, got: %v", errs)
+	}
+	if filtered := errs.WithoutWarnings(); len(filtered.Errors) != 1 || filtered.Errors[0].Severity != SeverityError {
+		t.Errorf("Expected one error after filtering, got: %v", filtered)
+	}
+
+	// Warnings of nested objects keep the parent valid
+	parent := Map(map[string]Schema{"user": schema})
+	if errs := parent.Validate(map[string]any{"user": input}, nil); errs.HasErrors() || len(errs.Warnings()) != 1 {
+		t.Errorf("Expected one nested warning, got: %v", errs)
+	}
+	if _, ok := TryParse[map[string]any](Union(schema, String()), input); !ok {
+		t.Error("Expected union option with warnings to match")
+	}
+
+	// Strict turns the warnings back into errors
+	if errs := schema.Clone().Strict().Validate(input, nil); !errs.HasErrors() {
+		t.Errorf("Expected strict error, got: %v", errs)
+	}
+}
+
+func TestMapSchema_Strip(t *testing.T) {
+	schema := Map(map[string]Schema{
+		"name":  String(),
+		"email": String().Email(),
+	}).Strip()
+	input := map[string]any{"name": "John", "email": "john@example.com", "isAdmin": true}
+
+	// Unknown keys are accepted and left out of the cleaned object
+	if err := schema.Validate(input, nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+	output, errs := schema.Parse(input)
+	if errs != nil {
+		t.Fatalf("Expected no errors, got: %v", errs)
+	}
+	if !reflect.DeepEqual(output, map[string]any{"name": "John", "email": "john@example.com"}) {
+		t.Errorf("Expected unknown key to be stripped, got %v", output)
+	}
+
+	// Nested objects strip their own unknown keys
+	parent := Map(map[string]Schema{"user": schema})
+	output, _ = parent.Parse(map[string]any{"user": input, "extra": 1})
+	if _, ok := output["user"].(map[string]any)["isAdmin"]; ok || output["extra"] != 1 {
+		t.Errorf("Expected only the nested unknown key to be stripped, got %v", output)
+	}
+
+	// Strict replaces Strip, and derived schemas keep the mode
+	if err := schema.Clone().Strict().Validate(input, nil); err == nil {
+		t.Error("Expected strict schema to reject the unknown key")
+	}
+	output, _ = schema.Omit("email").Parse(map[string]any{"name": "John", "isAdmin": true})
+	if !reflect.DeepEqual(output, map[string]any{"name": "John"}) {
+		t.Errorf("Expected Omit to keep Strip, got %v", output)
+	}
+}
+
+func TestMapSchema_KeySchema(t *testing.T) {
+	schema := Map(map[string]Schema{
+		"name": String(),
+	}).Catchall(Int()).KeySchema(String().Regex(`^[a-z][a-z0-9-]*$`, "Keys must be lowercase slugs"))
+
+	if err := schema.Validate(map[string]any{"name": "x", "page-views": 3}, nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+
+	err := schema.Validate(map[string]any{"name": "x", "Bad_Key": 1, "zz": "not an int"}, []any{"stats"})
+	if err == nil || len(err.Errors) != 2 {
+		t.Fatalf("Expected a key error and a value error, got: %v", err)
+	}
+	keyErr, valueErr := err.Errors[0], err.Errors[1]
+	if PathToString(keyErr.Path) != "stats.Bad_Key" || keyErr.Message != "Keys must be lowercase slugs" || keyErr.Meta["key"] != "Bad_Key" {
+		t.Errorf("Unexpected key error: %+v", keyErr)
+	}
+	if PathToString(valueErr.Path) != "stats.zz" || valueErr.Code != ErrCodeInvalidType || valueErr.Meta["key"] != nil {
+		t.Errorf("Unexpected value error: %+v", valueErr)
+	}
+
+	if _, err := schema.Parse(map[string]any{"name": "x", "Bad": 1}); err == nil {
+		t.Error("Expected Parse to fail on an invalid key")
+	}
+}
+
+func TestMapSchema_MaxKeys(t *testing.T) {
+	schema := Map(map[string]Schema{"name": String()}).Catchall(Int()).MaxKeys(2)
+
+	if err := schema.Validate(map[string]any{"name": "x", "a": 1}, nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+
+	for _, value := range []any{
+		map[string]any{"a": "bad", "b": "bad", "c": "bad"},
+		map[string]int{"a": 1, "b": 2, "c": 3},
+	} {
+		err := schema.Validate(value, nil)
+		if err == nil || len(err.Errors) != 1 || err.Errors[0].Code != ErrCodeResourceLimit || err.Errors[0].Meta["limit"] != 2 {
+			t.Errorf("Expected a single resource limit error for %v, got: %v", value, err)
+		}
+	}
+	if _, err := schema.Parse(map[string]any{"name": "x", "a": 1, "b": 2}); err == nil || err.Errors[0].Code != ErrCodeResourceLimit {
+		t.Errorf("Expected Parse to fail, got: %v", err)
+	}
+}
+
+func TestMapSchema_ShapeIsCopied(t *testing.T) {
+	shape := map[string]Schema{"name": String(), "age": Int()}
+	schema := Map(shape)
+
+	// Changing the caller's map after construction must not affect the schema
+	delete(shape, "age")
+	shape["email"] = String().Email()
+
+	err := schema.Validate(map[string]any{"name": "Alice"}, nil)
+	if err == nil || len(err.Errors) != 1 || PathToString(err.Errors[0].Path) != "age" {
+		t.Errorf("Expected only the original age field to be required, got: %v", err)
+	}
+}
@@ -0,0 +1,18 @@
+package gozod
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// MarshalValidated validates value against s and, only if it is valid, marshals it to JSON
+// The cleaned output of the schema is marshaled, so transforms such as stripping are applied
+// On validation failure the *ValidationErrors is returned as the error and nothing is marshaled
+// Warnings do not prevent marshaling
+func MarshalValidated(s Schema, value any) ([]byte, error) {
+	parsed, errs := parseValue(context.Background(), s, value, nil)
+	if errs.HasErrors() {
+		return nil, errs
+	}
+	return json.Marshal(parsed)
+}
@@ -0,0 +1,61 @@
+package gozod
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// trimSchema is a test schema whose output trims surrounding whitespace
+type trimSchema struct {
+	*StringSchema
+}
+
+func (s trimSchema) parse(ctx context.Context, value any, path []any) (any, *ValidationErrors) {
+	if errs := s.ValidateCtx(ctx, value, path); errs != nil {
+		return nil, errs
+	}
+	return strings.TrimSpace(value.(string)), nil
+}
+
+func TestMarshalValidated(t *testing.T) {
+	schema := Map(map[string]Schema{
+		"name": String().Min(2),
+		"age":  Int().Min(18),
+	})
+
+	data, err := MarshalValidated(schema, map[string]any{"name": "Ann", "age": 30})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if string(data) != `{"age":30,"name":"Ann"}` {
+		t.Errorf("Unexpected JSON: %s", data)
+	}
+}
+
+func TestMarshalValidated_Invalid(t *testing.T) {
+	schema := Map(map[string]Schema{"age": Int().Min(18)})
+
+	data, err := MarshalValidated(schema, map[string]any{"age": 12})
+	if err == nil {
+		t.Fatal("Expected validation error")
+	}
+	if data != nil {
+		t.Errorf("Expected nothing to be marshaled, got: %s", data)
+	}
+	var validationErrs *ValidationErrors
+	if !errors.As(err, &validationErrs) || validationErrs.Errors[0].Code != ErrCodeTooSmall {
+		t.Errorf("Expected *ValidationErrors with %s, got: %v", ErrCodeTooSmall, err)
+	}
+}
+
+func TestMarshalValidated_CleanedOutput(t *testing.T) {
+	data, err := MarshalValidated(trimSchema{String().Min(2)}, "  Ann  ")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if string(data) != `"Ann"` {
+		t.Errorf("Expected cleaned output, got: %s", data)
+	}
+}
@@ -0,0 +1,63 @@
+package gozod
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// defaultMessages holds the global messages set with SetDefaultMessage, keyed by error code
+var defaultMessages = struct {
+	sync.RWMutex
+	messages map[string]string
+}{messages: make(map[string]string)}
+
+// SetDefaultMessage sets the message used for code by every schema, replacing the built-in default
+// Messages set on a schema with CustomError or SetErrorFormatter still take precedence
+// An empty message restores the built-in default
+func SetDefaultMessage(code, message string) {
+	defaultMessages.Lock()
+	defer defaultMessages.Unlock()
+	if message == "" {
+		delete(defaultMessages.messages, code)
+		return
+	}
+	defaultMessages.messages[code] = message
+}
+
+// lookupDefaultMessage returns the global message set for code, if any
+func lookupDefaultMessage(code string) (string, bool) {
+	defaultMessages.RLock()
+	defer defaultMessages.RUnlock()
+	msg, ok := defaultMessages.messages[code]
+	return msg, ok
+}
+
+// interpolateMessage replaces each {key} placeholder in message with meta[key]
+// Placeholders without a matching meta key are left as they are
+func interpolateMessage(message string, meta map[string]any) string {
+	if len(meta) == 0 || !strings.Contains(message, "{") {
+		return message
+	}
+	var sb strings.Builder
+	for {
+		start := strings.IndexByte(message, '{')
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(message[start:], '}')
+		if end < 0 {
+			break
+		}
+		end += start
+		sb.WriteString(message[:start])
+		if value, ok := meta[message[start+1:end]]; ok {
+			sb.WriteString(fmt.Sprint(value))
+		} else {
+			sb.WriteString(message[start : end+1])
+		}
+		message = message[end+1:]
+	}
+	sb.WriteString(message)
+	return sb.String()
+}
@@ -0,0 +1,122 @@
+package gozod
+
+import (
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestSetDefaultMessage(t *testing.T) {
+	SetDefaultMessage(ErrCodeRequired, "This field is required")
+	t.Cleanup(func() { SetDefaultMessage(ErrCodeRequired, "") })
+
+	schema := Map(map[string]Schema{
+		"name":  String(),
+		"email": String().Email().CustomError(ErrCodeRequired, "Email is required"),
+		"age":   Int(),
+	})
+
+	err := schema.Validate(map[string]any{}, nil)
+	if err == nil || len(err.Errors) != 3 {
+		t.Fatalf("Expected 3 errors, got: %v", err)
+	}
+	for _, e := range err.Errors {
+		expected := "This field is required"
+		if e.Path[0] == "email" {
+			// CustomError overrides the global default
+			expected = "Email is required"
+		}
+		if e.Message != expected {
+			t.Errorf("Expected %q at %v, got %q", expected, e.Path, e.Message)
+		}
+	}
+
+	// Other codes keep their built-in messages
+	err = String().Min(3).Validate("ab", nil)
+	if err == nil || err.Errors[0].Message != "String must be at least 3 character(s) long, got 2" {
+		t.Errorf("Expected built-in message, got: %v", err)
+	}
+
+	// An empty message restores the built-in default
+	SetDefaultMessage(ErrCodeRequired, "")
+	err = String().Validate(nil, nil)
+	if err == nil || err.Errors[0].Message != "Required" {
+		t.Errorf("Expected built-in Required message, got: %v", err)
+	}
+}
+
+func TestSetDefaultMessage_FormatterAndRefinements(t *testing.T) {
+	SetDefaultMessage(ErrCodeCustomValidation, "Invalid value")
+	t.Cleanup(func() { SetDefaultMessage(ErrCodeCustomValidation, "") })
+
+	// Refinements without a message use the global default
+	err := String().Refine(func(any) (bool, string) { return false, "" }).Validate("x", nil)
+	if err == nil || err.Errors[0].Message != "Invalid value" {
+		t.Errorf("Expected global default, got: %v", err)
+	}
+
+	// A message returned by the refinement wins
+	err = String().Refine(func(any) (bool, string) { return false, "Too boring" }).Validate("x", nil)
+	if err == nil || err.Errors[0].Message != "Too boring" {
+		t.Errorf("Expected refinement message, got: %v", err)
+	}
+
+	// Error formatters receive the global default as the default message
+	schema := String().Refine(func(any) (bool, string) { return false, "" }).
+		SetErrorFormatter(func(path []any, code, defaultMessage string) string {
+			return "formatted: " + defaultMessage
+		})
+	err = schema.Validate("x", nil)
+	if err == nil || err.Errors[0].Message != "formatted: Invalid value" {
+		t.Errorf("Expected formatted global default, got: %v", err)
+	}
+}
+
+func TestCustomError_Placeholders(t *testing.T) {
+	schema := String().Min(3).CustomError(ErrCodeTooSmall, "must be at least {minimum} characters, got {actual}")
+	err := schema.Validate("ab", nil)
+	if err == nil || err.Errors[0].Message != "must be at least 3 characters, got 2" {
+		t.Errorf("Expected interpolated message, got: %v", err)
+	}
+
+	// Unknown placeholders are left untouched
+	schema = String().Max(2).CustomError(ErrCodeTooBig, "at most {maximum}, {unknown}")
+	err = schema.Validate("abc", nil)
+	if err == nil || err.Errors[0].Message != "at most 2, {unknown}" {
+		t.Errorf("Expected partially interpolated message, got: %v", err)
+	}
+
+	err = Int().Max(10).CustomError(ErrCodeTooBig, "{actual} exceeds {maximum}").Validate(12, nil)
+	if err == nil || err.Errors[0].Message != "12 exceeds 10" {
+		t.Errorf("Expected interpolated number message, got: %v", err)
+	}
+
+	err = Array(String()).Min(2).CustomError(ErrCodeTooSmall, "need {minimum} items").Validate([]any{"a"}, nil)
+	if err == nil || err.Errors[0].Message != "need 2 items" {
+		t.Errorf("Expected interpolated array message, got: %v", err)
+	}
+
+	err = BigInt().Max(big.NewInt(10)).CustomError(ErrCodeTooBig, "{actual} exceeds {maximum}").Validate(big.NewInt(12), nil)
+	if err == nil || err.Errors[0].Message != "12 exceeds 10" {
+		t.Errorf("Expected interpolated big integer message, got: %v", err)
+	}
+
+	err = Int().Unsigned().CustomError(ErrCodeTooSmall, "{actual} is below {minimum}").Validate(-1, nil)
+	if err == nil || err.Errors[0].Message != "-1 is below 0" {
+		t.Errorf("Expected interpolated unsigned message, got: %v", err)
+	}
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	err = Date().Min(start).CustomError(ErrCodeTooSmall, "must be on or after {minimum}").Validate(start.AddDate(0, 0, -1), nil)
+	if err == nil || err.Errors[0].Message != "must be on or after 2024-01-01T00:00:00Z" {
+		t.Errorf("Expected interpolated date message, got: %v", err)
+	}
+
+	// Global default messages are interpolated as well
+	SetDefaultMessage(ErrCodeTooSmall, "Too small: {actual} < {minimum}")
+	t.Cleanup(func() { SetDefaultMessage(ErrCodeTooSmall, "") })
+	err = Float().Min(1.5).Validate(1.0, nil)
+	if err == nil || err.Errors[0].Message != "Too small: 1 < 1.5" {
+		t.Errorf("Expected interpolated global message, got: %v", err)
+	}
+}
@@ -0,0 +1,120 @@
+package gozod
+
+import (
+	"context"
+	"fmt"
+)
+
+// absentKey marks a context used to validate a missing object key
+type absentKey struct{}
+
+// withAbsent returns a context recording that the value being validated is an absent key
+// rather than an explicit null
+func withAbsent(ctx context.Context) context.Context {
+	return context.WithValue(ctx, absentKey{}, true)
+}
+
+// isAbsent reports whether ctx was created by withAbsent
+func isAbsent(ctx context.Context) bool {
+	absent, _ := ctx.Value(absentKey{}).(bool)
+	return absent
+}
+
+// NullSchema validates that a value is explicitly null
+// A nil value passes only when it was present in the input (e.g. a map key set to nil),
+// so Union(String(), Null()) means "string or null" while still requiring the key
+type NullSchema struct {
+	BaseSchema
+}
+
+// Null creates a new null schema
+func Null() *NullSchema {
+	return &NullSchema{
+		BaseSchema: BaseSchema{required: true},
+	}
+}
+
+// Optional allows the key to be missing as well as set to null
+func (s *NullSchema) Optional() *NullSchema {
+	s.required = false
+	return s
+}
+
+// Or returns a union that accepts values matching either s or other
+func (s *NullSchema) Or(other Schema) *UnionSchema {
+	return Union(s, other)
+}
+
+// And returns an intersection that requires values to match both s and other
+func (s *NullSchema) And(other Schema) *IntersectionSchema {
+	return Intersection(s, other)
+}
+
+// Validate validates a value against the null schema
+func (s *NullSchema) Validate(value any, path []any) *ValidationErrors {
+	return s.ValidateCtx(context.Background(), value, path)
+}
+
+// ValidateCtx validates a value using ctx for cancellation and request scoping
+func (s *NullSchema) ValidateCtx(ctx context.Context, value any, path []any) *ValidationErrors {
+	var errors ValidationErrors
+
+	if value == nil {
+		if isAbsent(ctx) && s.required {
+			msg := s.getErrorMessage(path, ErrCodeRequired, "Required")
+			errors.Add(path, ErrCodeRequired, msg)
+		}
+		return errors.orNil()
+	}
+
+	msg := s.getErrorMessage(path, ErrCodeInvalidType, fmt.Sprintf("Expected null, got %T", value))
+	errors.Add(path, ErrCodeInvalidType, msg)
+	return errors.orNil()
+}
+
+// Title sets a short human-readable title used in generated documentation
+func (s *NullSchema) Title(title string) *NullSchema {
+	s.annotations.Title = title
+	return s
+}
+
+// Describe sets a description used in generated documentation
+// It does not affect validation
+func (s *NullSchema) Describe(description string) *NullSchema {
+	s.annotations.Description = description
+	return s
+}
+
+// Example adds an example value used in generated documentation
+func (s *NullSchema) Example(example any) *NullSchema {
+	s.annotations.Examples = append(s.annotations.Examples, example)
+	return s
+}
+
+// Clone returns an independent copy of the schema
+// Constraints, refinements and custom errors are copied, so changing the clone does not affect s
+func (s *NullSchema) Clone() *NullSchema {
+	c := *s
+	c.BaseSchema = s.BaseSchema.clone()
+	return &c
+}
+
+// CustomError sets a custom error message for a specific error code
+func (s *NullSchema) CustomError(code, message string) *NullSchema {
+	if s.BaseSchema.customErrors == nil {
+		s.BaseSchema.customErrors = make(map[string]string)
+	}
+	s.BaseSchema.customErrors[code] = message
+	return s
+}
+
+// SetErrorFormatter sets a custom error formatter function
+func (s *NullSchema) SetErrorFormatter(formatter CustomErrorFunc) *NullSchema {
+	s.BaseSchema.errorFormatter = formatter
+	return s
+}
+
+// Type returns the schema type
+func (s *NullSchema) Type() string {
+	return "null"
+}
@@ -0,0 +1,74 @@
+package gozod
+
+import (
+	"testing"
+)
+
+func TestNullSchema_Validate(t *testing.T) {
+	schema := Null()
+
+	// Explicit nil passes
+	if err := schema.Validate(nil, nil); err != nil {
+		t.Errorf("Expected no errors for nil, got: %v", err)
+	}
+
+	// Non-nil fails
+	err := schema.Validate("value", nil)
+	if err == nil {
+		t.Fatal("Expected error for non-nil value")
+	}
+	if err.Errors[0].Code != ErrCodeInvalidType {
+		t.Errorf("Expected error code %s, got %s", ErrCodeInvalidType, err.Errors[0].Code)
+	}
+}
+
+func TestNullSchema_AbsentVsNullInMap(t *testing.T) {
+	schema := Map(map[string]Schema{
+		"deletedAt": Union(String(), Null()),
+	})
+
+	// Explicit null passes
+	if err := schema.Validate(map[string]any{"deletedAt": nil}, nil); err != nil {
+		t.Errorf("Expected no errors for explicit null, got: %v", err)
+	}
+
+	// String passes
+	if err := schema.Validate(map[string]any{"deletedAt": "2024-01-01"}, nil); err != nil {
+		t.Errorf("Expected no errors for string, got: %v", err)
+	}
+
+	// Absent key fails as required
+	err := schema.Validate(map[string]any{}, nil)
+	if err == nil {
+		t.Fatal("Expected error for absent key")
+	}
+	if err.Errors[0].Code != ErrCodeRequired {
+		t.Errorf("Expected error code %s, got %s", ErrCodeRequired, err.Errors[0].Code)
+	}
+}
+
+func TestNullSchema_AbsentVsNullInStruct(t *testing.T) {
+	type record struct {
+		Note    *string `json:"note"`
+		Comment *string `json:"comment,omitempty"`
+	}
+	schema := Struct(Shape{
+		"note":    Null(),
+		"comment": Null(),
+	})
+
+	// Nil pointer without omitempty is null; with omitempty it is absent
+	err := schema.Validate(record{}, nil)
+	if err == nil {
+		t.Fatal("Expected error for omitted field")
+	}
+	if len(err.Errors) != 1 || !PathEqual(err.Errors[0].Path, []any{"comment"}) {
+		t.Errorf("Expected a single error at [comment], got: %v", err.Errors)
+	}
+}
+
+func TestNullSchema_Type(t *testing.T) {
+	if Null().Type() != "null" {
+		t.Errorf("Expected type 'null', got '%s'", Null().Type())
+	}
+}
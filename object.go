@@ -0,0 +1,109 @@
+package gozod
+
+import (
+	"fmt"
+	"maps"
+)
+
+// ObjectSchema is the set of shape composition methods shared by MapSchema and StructSchema
+// T is the concrete schema type, so chains keep their type when switching between the two
+type ObjectSchema[T Schema] interface {
+	Schema
+	Pick(keys ...string) T
+	Omit(keys ...string) T
+	Extend(shape Shape) T
+	Partial(keys ...string) T
+	Strict() T
+	Catchall(schema Schema) T
+	Fields() Shape
+	Keys() []string
+}
+
+var (
+	_ ObjectSchema[*MapSchema]    = (*MapSchema)(nil)
+	_ ObjectSchema[*StructSchema] = (*StructSchema)(nil)
+)
+
+// Object creates a new object schema
+// It is an alias for Map, matching the name used by Zod
+func Object(shape Shape) *MapSchema {
+	return Map(shape)
+}
+
+// pickShape returns the entries of shape named by keys
+// It panics if a key is not in the shape
+func pickShape(method string, shape map[string]Schema, keys []string) map[string]Schema {
+	picked := make(map[string]Schema, len(keys))
+	for _, key := range keys {
+		schema, ok := shape[key]
+		if !ok {
+			panic(fmt.Sprintf("gozod: %s key %q is not in the shape", method, key))
+		}
+		picked[key] = schema
+	}
+	return picked
+}
+
+// omitShape returns shape without the entries named by keys
+// It panics if a key is not in the shape
+func omitShape(shape map[string]Schema, keys []string) map[string]Schema {
+	omitted := maps.Clone(shape)
+	for _, key := range keys {
+		if _, ok := shape[key]; !ok {
+			panic(fmt.Sprintf("gozod: Omit key %q is not in the shape", key))
+		}
+		delete(omitted, key)
+	}
+	return omitted
+}
+
+// partialKeys returns the set of optional keys after Partial(keys...) on shape
+// With no keys, every key in the shape becomes optional
+func partialKeys(shape map[string]Schema, optional map[string]bool, keys []string) map[string]bool {
+	result := maps.Clone(optional)
+	if result == nil {
+		result = make(map[string]bool)
+	}
+	if len(keys) == 0 {
+		for key := range shape {
+			result[key] = true
+		}
+		return result
+	}
+	for key := range pickShape("Partial", shape, keys) {
+		result[key] = true
+	}
+	return result
+}
+
+// deepPartial makes every field of every object in a freshly cloned schema tree optional, in place
+// Arrays, unions, intersections and When schemas are relaxed through their nested schemas; Lazy and other schemas are left as is
+func deepPartial(s Schema) {
+	switch s := s.(type) {
+	case *MapSchema:
+		s.optional = partialKeys(s.shape, s.optional, nil)
+		for _, field := range s.shape {
+			deepPartial(field)
+		}
+	case *StructSchema:
+		s.optional = partialKeys(s.shape, s.optional, nil)
+		for _, field := range s.shape {
+			deepPartial(field)
+		}
+	case *ArraySchema:
+		deepPartial(s.elementSchema)
+	case *UnionSchema:
+		for _, option := range s.options {
+			deepPartial(option)
+		}
+	case *IntersectionSchema:
+		for _, schema := range s.schemas {
+			deepPartial(schema)
+		}
+	case *WhenSchema:
+		deepPartial(s.thenSchema)
+		if s.elseSchema != nil {
+			deepPartial(s.elseSchema)
+		}
+	}
+}
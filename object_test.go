@@ -0,0 +1,256 @@
+package gozod
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestObject_Alias(t *testing.T) {
+	schema := Object(Shape{"name": String()})
+	if schema.Type() != "object" {
+		t.Errorf("Expected type 'object', got %q", schema.Type())
+	}
+	if err := schema.Validate(map[string]any{"name": "Ann"}, nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+}
+
+func TestMapSchema_PickOmit(t *testing.T) {
+	base := Map(map[string]Schema{
+		"name":  String(),
+		"email": String().Email(),
+		"age":   Int(),
+	})
+
+	picked := base.Pick("name")
+	if err := picked.Validate(map[string]any{"name": "Ann"}, nil); err != nil {
+		t.Errorf("Expected no errors for picked schema, got: %v", err)
+	}
+
+	omitted := base.Omit("email", "age")
+	if err := omitted.Validate(map[string]any{"name": "Ann"}, nil); err != nil {
+		t.Errorf("Expected no errors for omitted schema, got: %v", err)
+	}
+
+	// The original schema is not modified
+	if err := base.Validate(map[string]any{"name": "Ann"}, nil); err == nil {
+		t.Error("Expected errors for missing fields on the original schema")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for unknown key")
+		}
+	}()
+	base.Pick("missing")
+}
+
+func TestMapSchema_Extend(t *testing.T) {
+	base := Map(map[string]Schema{"name": String()}).Partial()
+	extended := base.Extend(Shape{"name": String().Min(3), "age": Int()})
+
+	err := extended.Validate(map[string]any{"name": "Al", "age": 30}, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeTooSmall {
+		t.Errorf("Expected %s error for replaced field, got: %v", ErrCodeTooSmall, err)
+	}
+
+	// Extended keys replace the old definition, including Partial
+	if err := extended.Validate(map[string]any{"age": 30}, nil); err == nil {
+		t.Error("Expected error for missing extended field")
+	}
+}
+
+func TestMapSchema_Partial(t *testing.T) {
+	base := Map(map[string]Schema{
+		"name":  String().Min(2),
+		"email": String().Email(),
+	})
+
+	partial := base.Partial()
+	if err := partial.Validate(map[string]any{}, nil); err != nil {
+		t.Errorf("Expected no errors for empty object, got: %v", err)
+	}
+	if err := partial.Validate(map[string]any{"email": nil}, nil); err != nil {
+		t.Errorf("Expected no errors for nil value, got: %v", err)
+	}
+	// Present values are still validated
+	if err := partial.Validate(map[string]any{"name": "A"}, nil); err == nil {
+		t.Error("Expected error for invalid present value")
+	}
+
+	// Only the given keys become optional
+	err := base.Partial("name").Validate(map[string]any{}, nil)
+	if err == nil || len(err.Errors) != 1 || err.Errors[0].Path[0] != "email" {
+		t.Errorf("Expected only an email error, got: %v", err)
+	}
+}
+
+func TestMapSchema_Catchall(t *testing.T) {
+	schema := Map(map[string]Schema{"name": String()}).Strict().Catchall(Int())
+
+	if err := schema.Validate(map[string]any{"name": "Ann", "score": 10}, nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+
+	err := schema.Validate(map[string]any{"name": "Ann", "score": "high"}, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeInvalidType || err.Errors[0].Path[0] != "score" {
+		t.Errorf("Expected %s error at score, got: %v", ErrCodeInvalidType, err)
+	}
+}
+
+func TestStructSchema_ObjectMethods(t *testing.T) {
+	type User struct {
+		Name  string  `json:"name"`
+		Email string  `json:"email,omitempty"`
+		Age   *int    `json:"age"`
+		Score float64 `json:"score"`
+	}
+
+	base := Struct(Shape{
+		"name":  String().Min(2),
+		"email": String().Email(),
+		"age":   Int(),
+	})
+
+	if err := base.Pick("name").Validate(User{Name: "Ann"}, nil); err != nil {
+		t.Errorf("Expected no errors for picked schema, got: %v", err)
+	}
+	if err := base.Omit("email", "age").Validate(User{Name: "Ann"}, nil); err != nil {
+		t.Errorf("Expected no errors for omitted schema, got: %v", err)
+	}
+
+	// Nil pointers and empty omitempty fields count as missing
+	if err := base.Partial().Validate(User{Name: "Ann"}, nil); err != nil {
+		t.Errorf("Expected no errors for partial schema, got: %v", err)
+	}
+	if err := base.Validate(User{Name: "Ann"}, nil); err == nil {
+		t.Error("Expected errors for missing fields on the original schema")
+	}
+
+	extended := base.Partial().Extend(Shape{"score": Float().Positive()})
+	err := extended.Validate(User{Name: "Ann", Score: -1}, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeNotPositive {
+		t.Errorf("Expected %s error for extended field, got: %v", ErrCodeNotPositive, err)
+	}
+
+	catchall := base.Partial().Strict().Catchall(Float().Min(0))
+	err = catchall.Validate(User{Name: "Ann", Score: -1}, nil)
+	if err == nil || len(err.Errors) != 1 || err.Errors[0].Path[0] != "score" {
+		t.Errorf("Expected a single error at score, got: %v", err)
+	}
+}
+
+// Generic helpers can accept either object schema through ObjectSchema
+func withoutID[T ObjectSchema[T]](s T) T {
+	return s.Omit("id")
+}
+
+func TestObjectSchema_Interface(t *testing.T) {
+	m := withoutID(Map(map[string]Schema{"id": Int(), "name": String()}))
+	if err := m.Validate(map[string]any{"name": "Ann"}, nil); err != nil {
+		t.Errorf("Expected no errors for map, got: %v", err)
+	}
+
+	type Item struct {
+		Name string `json:"name"`
+	}
+	s := withoutID(Struct(Shape{"id": Int(), "name": String()}))
+	if err := s.Validate(Item{Name: "Ann"}, nil); err != nil {
+		t.Errorf("Expected no errors for struct, got: %v", err)
+	}
+}
+
+func TestMapSchema_DeepPartial(t *testing.T) {
+	base := Map(map[string]Schema{
+		"name": String(),
+		"address": Map(map[string]Schema{
+			"street": String(),
+			"city":   String().Min(2),
+		}),
+		"contacts": Array(Map(map[string]Schema{
+			"email": String().Email(),
+			"phone": String(),
+		})),
+	})
+
+	patch := base.DeepPartial()
+	tests := []map[string]any{
+		{},
+		{"address": map[string]any{}},
+		{"address": map[string]any{"city": "Oslo"}},
+		{"contacts": []any{map[string]any{"phone": "123"}}},
+	}
+	for _, value := range tests {
+		if err := patch.Validate(value, nil); err != nil {
+			t.Errorf("Expected no errors for %v, got: %v", value, err)
+		}
+	}
+
+	// Present values are still validated at every level
+	err := patch.Validate(map[string]any{
+		"address":  map[string]any{"city": "X"},
+		"contacts": []any{map[string]any{"email": "nope"}},
+	}, nil)
+	if err == nil || len(err.Errors) != 2 {
+		t.Errorf("Expected 2 errors, got: %v", err)
+	}
+
+	// The original schema tree is not modified
+	err = base.Validate(map[string]any{
+		"name":     "Ann",
+		"address":  map[string]any{},
+		"contacts": []any{},
+	}, nil)
+	if err == nil || len(err.Errors) != 2 {
+		t.Errorf("Expected nested required errors on the original schema, got: %v", err)
+	}
+}
+
+func TestMapSchema_DeepPartial_Union(t *testing.T) {
+	base := Map(map[string]Schema{
+		"addr": Union(Map(map[string]Schema{
+			"street": String(),
+			"city":   String(),
+		}), Null()),
+	})
+
+	patch := base.DeepPartial()
+	if err := patch.Validate(map[string]any{"addr": map[string]any{"city": "x"}}, nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+	if err := base.Validate(map[string]any{"addr": map[string]any{"city": "x"}}, nil); err == nil {
+		t.Error("Expected the original union to still require street")
+	}
+}
+
+func TestObject_FieldsAndKeys(t *testing.T) {
+	name := String()
+	tags := Array(String())
+	schema := Map(map[string]Schema{"name": name, "tags": tags, "age": Int()})
+
+	if keys := schema.Keys(); !reflect.DeepEqual(keys, []string{"age", "name", "tags"}) {
+		t.Errorf("Expected sorted keys, got %v", keys)
+	}
+
+	fields := schema.Fields()
+	if fields["name"] != name || fields["tags"] != tags {
+		t.Errorf("Expected the field schemas, got %v", fields)
+	}
+	if fields["tags"].(*ArraySchema).Element() == nil {
+		t.Error("Expected the array element schema")
+	}
+
+	// The returned map is a copy
+	delete(fields, "name")
+	if _, ok := schema.Fields()["name"]; !ok {
+		t.Error("Expected Fields to return a copy")
+	}
+
+	structSchema := Struct(Shape{"id": Int()})
+	if keys := structSchema.Keys(); !reflect.DeepEqual(keys, []string{"id"}) {
+		t.Errorf("Expected struct keys, got %v", keys)
+	}
+	if _, ok := structSchema.Fields()["id"].(*IntSchema); !ok {
+		t.Error("Expected the struct field schema")
+	}
+}
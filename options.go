@@ -0,0 +1,68 @@
+package gozod
+
+import (
+	"context"
+	"fmt"
+)
+
+// ValidateOptions holds per-call settings for a single validation run
+type ValidateOptions struct {
+	// Context holds request-scoped values (tenant ID, feature flags, ...) that
+	// refinements can read with SuperRefineContext.Value
+	Context map[string]any
+
+	// MaxDepth limits how deeply nested arrays and objects may be; 0 uses DefaultMaxDepth
+	MaxDepth int
+}
+
+// DefaultMaxDepth is the deepest nesting of arrays and objects that validation descends into
+// when ValidateOptions.MaxDepth is not set, so hostile input cannot recurse without bound
+// Change it at program start, before any validation runs
+var DefaultMaxDepth = 1000
+
+// depthKey is the context key under which the nesting depth of the value being validated is stored
+type depthKey struct{}
+
+// optionsKey is the context key under which ValidateOptions are stored
+type optionsKey struct{}
+
+// WithOptions returns a copy of ctx carrying opts, for use with ValidateCtx
+func WithOptions(ctx context.Context, opts ValidateOptions) context.Context {
+	return context.WithValue(ctx, optionsKey{}, &opts)
+}
+
+// optionsFromContext returns the options stored in ctx, or nil if there are none
+func optionsFromContext(ctx context.Context) *ValidateOptions {
+	opts, _ := ctx.Value(optionsKey{}).(*ValidateOptions)
+	return opts
+}
+
+// ValidateWithOptions validates value against s at the top level with per-call options
+func ValidateWithOptions(s Schema, value any, opts ValidateOptions) *ValidationErrors {
+	return s.ValidateCtx(WithOptions(context.Background(), opts), value, nil)
+}
+
+// enterDepth returns ctx one nesting level deeper, along with the depth limit
+// ok is false when the new level exceeds the limit
+func enterDepth(ctx context.Context) (next context.Context, limit int, ok bool) {
+	limit = DefaultMaxDepth
+	if opts := optionsFromContext(ctx); opts != nil && opts.MaxDepth > 0 {
+		limit = opts.MaxDepth
+	}
+	depth, _ := ctx.Value(depthKey{}).(int)
+	depth++
+	return context.WithValue(ctx, depthKey{}, depth), limit, depth <= limit
+}
+
+// enterNested is called by Array, Map and Struct before validating their contents
+// Past the depth limit it records a single ErrCodeTooDeep error and returns false,
+// so the contents are not validated
+func (b *BaseSchema) enterNested(ctx context.Context, path []any, errors *ValidationErrors) (context.Context, bool) {
+	next, limit, ok := enterDepth(ctx)
+	if !ok {
+		meta := map[string]any{"maxDepth": limit}
+		msg := b.getErrorMessageWithMeta(path, ErrCodeTooDeep, fmt.Sprintf("Maximum nesting depth of %d exceeded", limit), meta)
+		errors.AddWithMeta(path, ErrCodeTooDeep, msg, meta)
+	}
+	return next, ok
+}
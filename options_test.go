@@ -0,0 +1,89 @@
+package gozod
+
+import (
+	"context"
+	"testing"
+)
+
+func TestValidateWithOptions_RefinementReadsValue(t *testing.T) {
+	usernamesByTenant := map[string]map[string]bool{
+		"acme":   {"alice": true},
+		"globex": {},
+	}
+	schema := Map(map[string]Schema{
+		"username": String().SuperRefine(func(value any, ctx *SuperRefineContext) {
+			tenant, _ := ctx.Value("tenant").(string)
+			if usernamesByTenant[tenant][value.(string)] {
+				ctx.AddIssue(nil, ErrCodeCustomValidation, "Username is taken in this tenant")
+			}
+		}),
+	})
+	data := map[string]any{"username": "alice"}
+
+	err := ValidateWithOptions(schema, data, ValidateOptions{Context: map[string]any{"tenant": "acme"}})
+	if err == nil {
+		t.Fatal("Expected error for username taken in tenant")
+	}
+	if !PathEqual(err.Errors[0].Path, []any{"username"}) {
+		t.Errorf("Expected path [username], got %v", err.Errors[0].Path)
+	}
+
+	err = ValidateWithOptions(schema, data, ValidateOptions{Context: map[string]any{"tenant": "globex"}})
+	if err != nil {
+		t.Errorf("Expected no errors for other tenant, got: %v", err)
+	}
+}
+
+func TestWithOptions_ValidateCtx(t *testing.T) {
+	var seen any
+	schema := Int().SuperRefine(func(value any, ctx *SuperRefineContext) {
+		seen = ctx.Value("flag")
+	})
+
+	ctx := WithOptions(context.Background(), ValidateOptions{Context: map[string]any{"flag": true}})
+	_ = schema.ValidateCtx(ctx, 1, nil)
+	if seen != true {
+		t.Errorf("Expected flag to be true, got %v", seen)
+	}
+
+	// Without options, Value returns nil
+	_ = schema.Validate(1, nil)
+	if seen != nil {
+		t.Errorf("Expected nil without options, got %v", seen)
+	}
+}
+
+func TestValidateWithOptions_MaxDepth(t *testing.T) {
+	var node *MapSchema
+	node = Map(map[string]Schema{
+		"children": Array(Lazy(func() Schema { return node })).Optional(),
+	})
+
+	// nest builds a chain of n nodes, i.e. 2n-1 levels of nested objects and arrays
+	nest := func(n int) any {
+		value := map[string]any{}
+		for i := 1; i < n; i++ {
+			value = map[string]any{"children": []any{value}}
+		}
+		return value
+	}
+
+	if err := ValidateWithOptions(node, nest(3), ValidateOptions{MaxDepth: 5}); err != nil {
+		t.Errorf("Expected no errors at the limit, got: %v", err)
+	}
+
+	err := ValidateWithOptions(node, nest(4), ValidateOptions{MaxDepth: 5})
+	if err == nil || len(err.Errors) != 1 {
+		t.Fatalf("Expected a single error, got: %v", err)
+	}
+	e := err.Errors[0]
+	if e.Code != ErrCodeTooDeep || e.Meta["maxDepth"] != 5 || PathToString(e.Path) != "children[0].children[0].children" {
+		t.Errorf("Unexpected error: %+v", e)
+	}
+
+	// Without options the default limit applies
+	err = node.Validate(nest(DefaultMaxDepth), nil)
+	if err == nil || err.Errors[0].Code != ErrCodeTooDeep || err.Errors[0].Meta["maxDepth"] != DefaultMaxDepth {
+		t.Errorf("Expected the default depth limit, got: %v", err)
+	}
+}
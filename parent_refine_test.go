@@ -0,0 +1,76 @@
+package gozod
+
+import (
+	"testing"
+)
+
+func TestRefineWithParent_ConditionalRequired(t *testing.T) {
+	// zipCode is required only when country is set
+	schema := Map(map[string]Schema{
+		"country": String().Nilable(),
+		"zipCode": String().Nilable().RefineWithParent(func(value, parent any) (bool, string) {
+			if parent.(map[string]any)["country"] != nil && value == nil {
+				return false, "zipCode is required when country is set"
+			}
+			return true, ""
+		}),
+	})
+
+	if err := schema.Validate(map[string]any{}, nil); err != nil {
+		t.Errorf("Expected no errors without country, got: %v", err)
+	}
+	if err := schema.Validate(map[string]any{"country": "US", "zipCode": "94103"}, nil); err != nil {
+		t.Errorf("Expected no errors with both fields, got: %v", err)
+	}
+
+	err := schema.Validate(map[string]any{"country": "US"}, nil)
+	if err == nil || len(err.Errors) != 1 {
+		t.Fatalf("Expected 1 error, got: %v", err)
+	}
+	if err.Errors[0].Code != ErrCodeCustomValidation || !PathEqual(err.Errors[0].Path, []any{"zipCode"}) {
+		t.Errorf("Expected %s error at zipCode, got: %v", ErrCodeCustomValidation, err.Errors[0])
+	}
+	if err.Errors[0].Message != "zipCode is required when country is set" {
+		t.Errorf("Unexpected message: %q", err.Errors[0].Message)
+	}
+}
+
+func TestRefineWithParent_SkippedWhenFieldInvalid(t *testing.T) {
+	called := false
+	schema := Map(map[string]Schema{
+		"age": Int().Min(18).RefineWithParent(func(value, parent any) (bool, string) {
+			called = true
+			return true, ""
+		}),
+	})
+
+	if err := schema.Validate(map[string]any{"age": 10}, nil); err == nil || len(err.Errors) != 1 {
+		t.Errorf("Expected only the min error, got: %v", err)
+	}
+	if called {
+		t.Error("Expected parent refinement to be skipped for an invalid field")
+	}
+}
+
+func TestRefineWithParent_Struct(t *testing.T) {
+	type Signup struct {
+		Password string `json:"password"`
+		Confirm  string `json:"confirm"`
+	}
+
+	schema := Struct(Shape{
+		"password": String().Min(8),
+		"confirm": String().RefineWithParent(func(value, parent any) (bool, string) {
+			return value == parent.(Signup).Password, "Passwords do not match"
+		}),
+	})
+
+	if err := schema.Validate(&Signup{Password: "secret123", Confirm: "secret123"}, nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+
+	err := schema.Validate(Signup{Password: "secret123", Confirm: "secret124"}, nil)
+	if err == nil || !PathEqual(err.Errors[0].Path, []any{"confirm"}) {
+		t.Errorf("Expected error at confirm, got: %v", err)
+	}
+}
@@ -0,0 +1,83 @@
+package gozod
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// parser is implemented by schemas whose output differs from their input,
+// e.g. schemas that strip unknown keys or coerce values
+// parse validates value and returns the cleaned output
+type parser interface {
+	parse(ctx context.Context, value any, path []any) (any, *ValidationErrors)
+}
+
+// parseValue validates value against s and returns the output value
+// Schemas without a transform return value unchanged
+// A valid value is returned along with any warnings
+func parseValue(ctx context.Context, s Schema, value any, path []any) (any, *ValidationErrors) {
+	if p, ok := s.(parser); ok {
+		return p.parse(ctx, value, path)
+	}
+	errs := s.ValidateCtx(ctx, value, path)
+	if errs.HasErrors() {
+		return nil, errs
+	}
+	return value, errs
+}
+
+// Parse validates value against s and returns the output as T
+// The output is the cleaned value for schemas that transform their input
+// A valid nil value (e.g. for a nilable schema) yields the zero value of T
+// If the value is valid but not a T, an ErrCodeInvalidType error is returned
+// A valid value may come with warnings (see StrictWarn); use HasErrors to tell them from a failure
+func Parse[T any](s Schema, value any) (T, *ValidationErrors) {
+	return parseAs[T](context.Background(), s, value)
+}
+
+// parseAs is Parse with a caller-provided context
+func parseAs[T any](ctx context.Context, s Schema, value any) (T, *ValidationErrors) {
+	var zero T
+	value, errs := parseValue(ctx, s, value, nil)
+	if errs.HasErrors() {
+		return zero, errs
+	}
+	if value == nil {
+		return zero, errs
+	}
+	typed, ok := value.(T)
+	if !ok {
+		errs := &ValidationErrors{}
+		errs.Add(nil, ErrCodeInvalidType, fmt.Sprintf("Expected %v, got %T", reflect.TypeOf((*T)(nil)).Elem(), value))
+		return zero, errs
+	}
+	return typed, errs
+}
+
+// TryParse validates value against s and returns it as T with a success flag
+// It is intended for hot paths that only need pass/fail: no error object is returned,
+// and validating a value that passes does not allocate
+func TryParse[T any](s Schema, value any) (T, bool) {
+	var zero T
+	value, errs := parseValue(context.Background(), s, value, nil)
+	if errs.HasErrors() {
+		return zero, false
+	}
+	if value == nil {
+		return zero, true
+	}
+	typed, ok := value.(T)
+	return typed, ok
+}
+
+// Check validates value against s and returns nil on success or the *ValidationErrors as an error
+// Unlike assigning the result of Validate to an error variable, it never returns a non-nil
+// error holding a nil pointer, so `if err := gozod.Check(s, v); err != nil` is safe
+// Warnings are left out: a value with only warnings passes
+func Check(s Schema, value any) error {
+	if errs := s.Validate(value, nil).WithoutWarnings(); errs != nil {
+		return errs
+	}
+	return nil
+}
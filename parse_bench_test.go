@@ -0,0 +1,23 @@
+package gozod
+
+import (
+	"testing"
+)
+
+var tryParseStringSchema = String().Min(2).Max(50)
+
+var tryParseIntSchema = Int().Min(0).Max(150)
+
+func BenchmarkTryParse_String(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = TryParse[string](tryParseStringSchema, "John Doe")
+	}
+}
+
+func BenchmarkTryParse_Int(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = TryParse[int](tryParseIntSchema, 30)
+	}
+}
@@ -0,0 +1,81 @@
+package gozod
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse_TypedValue(t *testing.T) {
+	name, err := Parse[string](String().Min(2), "Alice")
+	if err != nil {
+		t.Fatalf("Expected no errors, got: %v", err)
+	}
+	if name != "Alice" {
+		t.Errorf("Expected 'Alice', got %q", name)
+	}
+
+	// Validation failure returns the errors and the zero value
+	name, err = Parse[string](String().Min(2), "A")
+	if err == nil || name != "" {
+		t.Errorf("Expected validation error and zero value, got %q, %v", name, err)
+	}
+
+	// Valid value of a different Go type
+	_, err = Parse[int64](Int(), 42)
+	if err == nil || err.Errors[0].Code != ErrCodeInvalidType {
+		t.Errorf("Expected %s error for type mismatch, got: %v", ErrCodeInvalidType, err)
+	}
+
+	// Nilable nil yields the zero value
+	n, err := Parse[int](Int().Nilable(), nil)
+	if err != nil || n != 0 {
+		t.Errorf("Expected zero value and no errors, got %d, %v", n, err)
+	}
+}
+
+func TestTryParse(t *testing.T) {
+	age, ok := TryParse[int](Int().Min(0), 30)
+	if !ok || age != 30 {
+		t.Errorf("Expected (30, true), got (%d, %v)", age, ok)
+	}
+
+	age, ok = TryParse[int](Int().Min(0), -1)
+	if ok || age != 0 {
+		t.Errorf("Expected (0, false), got (%d, %v)", age, ok)
+	}
+
+	_, ok = TryParse[string](Int(), 1)
+	if ok {
+		t.Error("Expected false for type mismatch")
+	}
+}
+
+func TestTryParse_ZeroAllocsOnSuccess(t *testing.T) {
+	schema := String().Min(2).Max(50)
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = TryParse[string](schema, "hello")
+	})
+	if allocs != 0 {
+		t.Errorf("Expected 0 allocations on success, got %v", allocs)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	schema := Map(map[string]Schema{"age": Int().Min(18)})
+
+	if err := Check(schema, map[string]any{"age": 30}); err != nil {
+		t.Errorf("Expected nil error, got: %v", err)
+	}
+
+	err := Check(schema, map[string]any{"age": 12})
+	if err == nil {
+		t.Fatal("Expected error")
+	}
+	var validationErrs *ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		t.Fatalf("Expected *ValidationErrors, got %T", err)
+	}
+	if validationErrs.Errors[0].Code != ErrCodeTooSmall {
+		t.Errorf("Expected %s, got %s", ErrCodeTooSmall, validationErrs.Errors[0].Code)
+	}
+}
@@ -0,0 +1,49 @@
+package gozod
+
+import (
+	"regexp"
+	"strings"
+)
+
+// e164Regex matches E.164 numbers: a leading "+" and up to 15 digits
+var e164Regex = regexp.MustCompile(`^\+[1-9]\d{1,14}$`)
+
+// phoneRule describes the numbering plan of a region
+type phoneRule struct {
+	countryCode string         // Calling code without the "+"
+	trunkPrefix string         // Prefix dialed before national numbers (optional in input)
+	national    *regexp.Regexp // National significant number, digits only
+}
+
+// phoneRegions holds the supported regions for StringSchema.Phone
+// This is a pragmatic subset of the numbering plans, not full libphonenumber parity
+var phoneRegions = map[string]phoneRule{
+	"US": {countryCode: "1", trunkPrefix: "1", national: regexp.MustCompile(`^[2-9]\d{2}[2-9]\d{6}$`)},
+	"CA": {countryCode: "1", trunkPrefix: "1", national: regexp.MustCompile(`^[2-9]\d{2}[2-9]\d{6}$`)},
+	"GB": {countryCode: "44", trunkPrefix: "0", national: regexp.MustCompile(`^[1-9]\d{9}$`)},
+	"DE": {countryCode: "49", trunkPrefix: "0", national: regexp.MustCompile(`^[1-9]\d{5,13}$`)},
+	"FR": {countryCode: "33", trunkPrefix: "0", national: regexp.MustCompile(`^[1-9]\d{8}$`)},
+}
+
+// phoneSeparators removes the formatting characters allowed in national numbers
+var phoneSeparators = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "")
+
+// isValidPhone reports whether str is a valid phone number
+// An empty region means strict E.164; otherwise the region's national rules apply
+func isValidPhone(str, region string) bool {
+	if region == "" {
+		return e164Regex.MatchString(str)
+	}
+
+	rule := phoneRegions[region]
+	digits := phoneSeparators.Replace(str)
+	if strings.HasPrefix(digits, "+") {
+		if !strings.HasPrefix(digits[1:], rule.countryCode) {
+			return false
+		}
+		digits = digits[1+len(rule.countryCode):]
+	} else if strings.HasPrefix(digits, rule.trunkPrefix) && !rule.national.MatchString(digits) {
+		digits = digits[len(rule.trunkPrefix):]
+	}
+	return rule.national.MatchString(digits)
+}
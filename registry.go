@@ -0,0 +1,65 @@
+package gozod
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// refinementRegistry holds named refinements shared across schemas
+var refinementRegistry = struct {
+	sync.RWMutex
+	funcs map[string]RefineFunc
+}{funcs: make(map[string]RefineFunc)}
+
+// RegisterRefinement registers a reusable refinement under the given name
+// Registered refinements can be attached to any schema with UseRefinement
+// Registering the same name twice panics, like database/sql.Register
+func RegisterRefinement(name string, fn RefineFunc) {
+	if fn == nil {
+		panic(fmt.Sprintf("gozod: refinement %q is nil", name))
+	}
+	refinementRegistry.Lock()
+	defer refinementRegistry.Unlock()
+	if _, exists := refinementRegistry.funcs[name]; exists {
+		panic(fmt.Sprintf("gozod: refinement %q is already registered", name))
+	}
+	refinementRegistry.funcs[name] = fn
+}
+
+// RegisteredRefinements returns the names of all registered refinements in sorted order
+func RegisteredRefinements() []string {
+	refinementRegistry.RLock()
+	defer refinementRegistry.RUnlock()
+	names := make([]string, 0, len(refinementRegistry.funcs))
+	for name := range refinementRegistry.funcs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// lookupRefinement returns the refinement registered under name
+// It panics if no refinement was registered with that name
+func lookupRefinement(name string) RefineFunc {
+	refinementRegistry.RLock()
+	defer refinementRegistry.RUnlock()
+	fn, ok := refinementRegistry.funcs[name]
+	if !ok {
+		panic(fmt.Sprintf("gozod: unknown refinement %q", name))
+	}
+	return fn
+}
+
+// useRefinement attaches a registered refinement to the schema and records its name
+func (b *BaseSchema) useRefinement(name string) {
+	b.addRefinement(lookupRefinement(name))
+	b.refinementNames = append(b.refinementNames, name)
+}
+
+// RefinementNames returns the names of registered refinements used by the schema, in the order they were added
+func (b *BaseSchema) RefinementNames() []string {
+	names := make([]string, len(b.refinementNames))
+	copy(names, b.refinementNames)
+	return names
+}
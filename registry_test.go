@@ -0,0 +1,84 @@
+package gozod
+
+import (
+	"strings"
+	"testing"
+)
+
+// registerForTest registers fn under name and removes it again when the test ends,
+// so the global registry stays clean and the suite can run more than once (go test -count=2)
+func registerForTest(t *testing.T, name string, fn RefineFunc) {
+	t.Helper()
+	RegisterRefinement(name, fn)
+	t.Cleanup(func() {
+		refinementRegistry.Lock()
+		defer refinementRegistry.Unlock()
+		delete(refinementRegistry.funcs, name)
+	})
+}
+
+func TestRegisterRefinement_SharedAcrossSchemas(t *testing.T) {
+	registerForTest(t, "test.noSpaces", func(value any) (bool, string) {
+		str, ok := value.(string)
+		return ok && !strings.Contains(str, " "), "Must not contain spaces"
+	})
+
+	username := String().Min(3).UseRefinement("test.noSpaces")
+	tags := Array(String()).UseRefinement("test.noSpaces")
+
+	// Valid username
+	if err := username.Validate("alice", nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+
+	// Invalid username
+	err := username.Validate("alice smith", nil)
+	if err == nil {
+		t.Fatal("Expected error for username with spaces")
+	}
+	if err.Errors[0].Code != ErrCodeCustomValidation || err.Errors[0].Message != "Must not contain spaces" {
+		t.Errorf("Unexpected error: %+v", err.Errors[0])
+	}
+
+	// The same refinement applied to a different schema type (value is not a string)
+	err = tags.Validate([]string{"a"}, nil)
+	if err == nil {
+		t.Error("Expected refinement to fail for non-string value")
+	}
+
+	// Introspection
+	names := username.RefinementNames()
+	if len(names) != 1 || names[0] != "test.noSpaces" {
+		t.Errorf("Expected [test.noSpaces], got %v", names)
+	}
+	found := false
+	for _, name := range RegisteredRefinements() {
+		if name == "test.noSpaces" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("Expected test.noSpaces in RegisteredRefinements()")
+	}
+}
+
+func TestUseRefinement_UnknownNamePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for unknown refinement")
+		}
+	}()
+	String().UseRefinement("test.doesNotExist")
+}
+
+func TestRegisterRefinement_DuplicatePanics(t *testing.T) {
+	fn := func(value any) (bool, string) { return true, "" }
+	registerForTest(t, "test.duplicate", fn)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for duplicate registration")
+		}
+	}()
+	RegisterRefinement("test.duplicate", fn)
+}
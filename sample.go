@@ -0,0 +1,470 @@
+package gozod
+
+import (
+	"math"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+)
+
+// maxSampleDepth bounds how many Lazy schemas GenerateSample resolves on one path,
+// so that recursive schemas produce a finite value
+const maxSampleDepth = 8
+
+// sampleTime is the instant used for date, time and datetime samples
+var sampleTime = time.Date(2024, time.January, 15, 13, 45, 0, 0, time.UTC)
+
+// phoneSamples holds a valid number for each supported phone region, keyed by region code ("" for E.164)
+var phoneSamples = map[string]string{
+	"":   "+14155552671",
+	"US": "+14155552671",
+	"CA": "+16135550123",
+	"GB": "+442071234567",
+	"DE": "+4930123456",
+	"FR": "+33123456789",
+}
+
+// GenerateSample returns a representative value for s, for API examples and test data
+// The first value set with Example is used when present; otherwise the value is built from the constraints:
+// strings meet Min, Max and the common formats, numbers fall within their bounds, arrays have the required
+// length, and maps contain every field of the shape
+// OneOf and Union pick their first option, Intersection merges the samples of object schemas,
+// When picks its then schema, Any produces an empty map, and Struct schemas produce a map[string]any
+// Numeric elements of Unique and SortedUnique arrays are offset by their index so they are distinct and increasing
+// Regex patterns, refinements and the uniqueness of other elements are not taken into account, so validate the
+// sample when an exact match matters
+func GenerateSample(s Schema) any {
+	return generateSample(s, 0)
+}
+
+// generateSample builds the sample for s; depth counts the Lazy schemas resolved so far
+func generateSample(s Schema, depth int) any {
+	if annotated, ok := s.(interface{ Annotations() Annotations }); ok {
+		if examples := annotated.Annotations().Examples; len(examples) > 0 {
+			return examples[0]
+		}
+	}
+
+	switch s := s.(type) {
+	case *StringSchema:
+		return sampleString(s)
+	case *IntSchema:
+		return sampleInt(s)
+	case *FloatSchema:
+		return sampleFloat(s)
+	case *BoolSchema:
+		return true
+	case *BigIntSchema:
+		return sampleBigInt(s)
+	case *DateSchema:
+		return sampleDate(s)
+	case *DurationSchema:
+		return clampDuration(time.Minute, s.min, s.max)
+	case *ArraySchema:
+		return sampleArray(s, depth)
+	case *MapSchema:
+		return sampleShape(s.shape, depth)
+	case *StructSchema:
+		return sampleShape(s.shape, depth)
+	case *UnionSchema:
+		if len(s.options) == 0 {
+			return nil
+		}
+		return generateSample(s.options[0], depth)
+	case *IntersectionSchema:
+		samples := make([]any, len(s.schemas))
+		for i, schema := range s.schemas {
+			samples[i] = generateSample(schema, depth)
+		}
+		if len(samples) == 0 {
+			return nil
+		}
+		return mergeOutputs(samples)
+	case *LiteralSchema:
+		return s.value
+	case *AnySchema:
+		if s.unknown {
+			return nil
+		}
+		return map[string]any{}
+	case *WhenSchema:
+		return generateSample(s.thenSchema, depth)
+	case *LazySchema:
+		if depth >= maxSampleDepth {
+			return nil
+		}
+		return generateSample(s.resolve(), depth+1)
+	default:
+		// Null, Validated and schemas defined outside this package
+		return nil
+	}
+}
+
+// sampleString returns a string meeting the format, affix and length constraints of s
+func sampleString(s *StringSchema) string {
+	if len(s.oneOf) > 0 {
+		return s.oneOf[0]
+	}
+
+	switch {
+	case s.email:
+		return "user@example.com"
+	case s.url:
+		return "https://example.com"
+	case s.datetime != nil:
+		return sampleTime.Format(*s.datetime)
+	case s.dateOnly:
+		return sampleTime.Format(time.DateOnly)
+	case s.timeOnly:
+		return sampleTime.Format(time.TimeOnly)
+	case s.duration:
+		return clampDuration(time.Minute, s.minDuration, s.maxDuration).String()
+	case s.base64:
+		return "ZXhhbXBsZQ=="
+	case s.base64URL:
+		return "ZXhhbXBsZQ"
+	case s.hashBits > 0:
+		return strings.Repeat("a", s.hashBits/4)
+	case s.hex != nil:
+		return "deadbeef"
+	case s.phone != nil:
+		return phoneSamples[*s.phone]
+	case s.creditCard:
+		return "4242424242424242"
+	case s.mac != nil:
+		return "00:1a:2b:3c:4d:5e"
+	case len(s.cidrs) > 0:
+		return s.cidrs[0].IP.String()
+	case s.hostname, s.fqdn:
+		return "example.com"
+	case s.nanoIDLength > 0:
+		return strings.Repeat("V1StGXR8_Z5jdHi6B-myT", s.nanoIDLength/21+1)[:s.nanoIDLength]
+	case s.cuid2:
+		return "tz4a98xxat96iws9zmbrgj3a"
+	case s.ulid:
+		return "01ARZ3NDEKTSV4RRFFQ69G5FAV"
+	case s.emoji:
+		return "😀"
+	case s.password != nil:
+		return samplePassword(*s.password)
+	}
+
+	var prefix, middle, suffix string
+	if s.startsWith != nil {
+		prefix = *s.startsWith
+	}
+	if s.includes != nil {
+		middle = *s.includes
+	}
+	if s.endsWith != nil {
+		suffix = *s.endsWith
+	}
+	minLength, maxLength := s.minLength, s.maxLength
+	if s.length != nil {
+		minLength, maxLength = s.length, s.length
+	}
+	if prefix == "" && middle == "" && suffix == "" {
+		middle = "example"
+		if maxLength != nil && len(middle) > *maxLength {
+			middle = middle[:max(*maxLength, 0)]
+		}
+		if s.maxBytes != nil && len(middle) > *s.maxBytes {
+			middle = middle[:max(*s.maxBytes, 0)]
+		}
+	}
+	if length := s.textLength(prefix + middle + suffix); minLength != nil && length < *minLength {
+		middle += strings.Repeat("x", *minLength-length)
+	}
+	if size := len(prefix + middle + suffix); s.minBytes != nil && size < *s.minBytes {
+		middle += strings.Repeat("x", *s.minBytes-size)
+	}
+	return prefix + middle + suffix
+}
+
+// samplePassword returns a password with one character of each required class,
+// padded to MinLength (at least eight characters) and no longer than MaxLength
+func samplePassword(opts PasswordOptions) string {
+	var sample string
+	if opts.RequireUpper {
+		sample += "A"
+	}
+	if opts.RequireLower {
+		sample += "a"
+	}
+	if opts.RequireDigit {
+		sample += "1"
+	}
+	if opts.RequireSymbol {
+		sample += "!"
+	}
+	length := max(opts.MinLength, 8)
+	if opts.MaxLength > 0 {
+		length = min(length, opts.MaxLength)
+	}
+	if len(sample) < length {
+		sample += strings.Repeat("x", length-len(sample))
+	}
+	return sample
+}
+
+// clampDuration moves d into the optional bounds min and max
+func clampDuration(d time.Duration, min, max *time.Duration) time.Duration {
+	if min != nil && d < *min {
+		d = *min
+	}
+	if max != nil && d > *max {
+		d = *max
+	}
+	return d
+}
+
+// numberBounds collects the tightest lower and upper bounds of a numeric schema
+type numberBounds struct {
+	lower, upper         *float64
+	lowerExcl, upperExcl bool
+}
+
+// atLeast adds a lower bound, exclusive or inclusive
+func (b *numberBounds) atLeast(v float64, exclusive bool) {
+	if b.lower == nil || v > *b.lower || (v == *b.lower && exclusive) {
+		b.lower, b.lowerExcl = &v, exclusive
+	}
+}
+
+// atMost adds an upper bound, exclusive or inclusive
+func (b *numberBounds) atMost(v float64, exclusive bool) {
+	if b.upper == nil || v < *b.upper || (v == *b.upper && exclusive) {
+		b.upper, b.upperExcl = &v, exclusive
+	}
+}
+
+// below reports whether v violates the lower bound
+func (b *numberBounds) below(v float64) bool {
+	return b.lower != nil && (v < *b.lower || (v == *b.lower && b.lowerExcl))
+}
+
+// above reports whether v violates the upper bound
+func (b *numberBounds) above(v float64) bool {
+	return b.upper != nil && (v > *b.upper || (v == *b.upper && b.upperExcl))
+}
+
+// pick returns zero moved into the bounds, one unit inside any exclusive bound
+// If a unit does not fit between the bounds, their midpoint is used
+func (b *numberBounds) pick() float64 {
+	v := 0.0
+	if b.below(v) {
+		v = *b.lower
+		if b.lowerExcl {
+			v++
+		}
+	}
+	if b.above(v) {
+		v = *b.upper
+		if b.upperExcl {
+			v--
+		}
+		if b.below(v) {
+			v = (*b.lower + *b.upper) / 2
+		}
+	}
+	return v
+}
+
+// alignTo moves v to base plus a multiple of step, preferring the next value up
+func (b *numberBounds) alignTo(v, base, step float64) float64 {
+	aligned := base + math.Ceil((v-base)/step)*step
+	if b.above(aligned) {
+		aligned = base + math.Floor((v-base)/step)*step
+	}
+	return aligned
+}
+
+// sampleInt returns an int within the bounds of s
+func sampleInt(s *IntSchema) int {
+	var b numberBounds
+	if s.min != nil {
+		b.atLeast(float64(*s.min), false)
+	}
+	if s.max != nil {
+		b.atMost(float64(*s.max), false)
+	}
+	if s.gt != nil {
+		b.atLeast(float64(*s.gt), true)
+	}
+	if s.lt != nil {
+		b.atMost(float64(*s.lt), true)
+	}
+	if s.positive {
+		b.atLeast(0, true)
+	}
+	if s.negative {
+		b.atMost(0, true)
+	}
+	if s.nonNegative || s.unsigned {
+		b.atLeast(0, false)
+	}
+	if s.nonPositive {
+		b.atMost(0, false)
+	}
+
+	v := math.Ceil(b.pick())
+	if s.multipleOf != nil && *s.multipleOf != 0 {
+		v = b.alignTo(v, 0, math.Abs(float64(*s.multipleOf)))
+	}
+	if s.step != nil {
+		base := 0.0
+		if s.min != nil {
+			base = float64(*s.min)
+		}
+		v = b.alignTo(v, base, float64(*s.step))
+	}
+	return int(v)
+}
+
+// sampleFloat returns a float64 within the bounds of s
+func sampleFloat(s *FloatSchema) float64 {
+	var b numberBounds
+	if s.min != nil {
+		b.atLeast(*s.min, false)
+	}
+	if s.max != nil {
+		b.atMost(*s.max, false)
+	}
+	if s.gt != nil {
+		b.atLeast(*s.gt, true)
+	}
+	if s.lt != nil {
+		b.atMost(*s.lt, true)
+	}
+	if s.positive {
+		b.atLeast(0, true)
+	}
+	if s.negative {
+		b.atMost(0, true)
+	}
+	if s.nonNegative {
+		b.atLeast(0, false)
+	}
+	if s.nonPositive {
+		b.atMost(0, false)
+	}
+
+	v := b.pick()
+	if s.multipleOf != nil && *s.multipleOf != 0 {
+		v = b.alignTo(v, 0, math.Abs(*s.multipleOf))
+	}
+	if s.step != nil {
+		base := 0.0
+		if s.min != nil {
+			base = *s.min
+		}
+		v = b.alignTo(v, base, *s.step)
+	}
+	return v
+}
+
+// sampleBigInt returns a *big.Int within the bounds of s
+func sampleBigInt(s *BigIntSchema) *big.Int {
+	v := big.NewInt(0)
+	if s.positive {
+		v.SetInt64(1)
+	}
+	if s.negative {
+		v.SetInt64(-1)
+	}
+	if s.min != nil && v.Cmp(s.min) < 0 {
+		v.Set(s.min)
+	}
+	if s.max != nil && v.Cmp(s.max) > 0 {
+		v.Set(s.max)
+	}
+	return v
+}
+
+// sampleDate returns sampleTime, clamped to the bounds of s
+func sampleDate(s *DateSchema) time.Time {
+	t := sampleTime
+	if s.min != nil && t.Before(*s.min) {
+		t = *s.min
+	}
+	if s.max != nil && t.After(*s.max) {
+		t = *s.max
+	}
+	return t
+}
+
+// sampleArray returns a []any with the required values and at least one element, within the length bounds of s
+func sampleArray(s *ArraySchema, depth int) []any {
+	if s.permutationOf != nil {
+		return slices.Clone(s.permutationOf)
+	}
+
+	length := max(1, len(s.includes))
+	if s.minLength != nil {
+		length = max(length, *s.minLength)
+	}
+	if s.maxLength != nil {
+		length = min(length, *s.maxLength)
+	}
+
+	values := make([]any, 0, length)
+	values = append(values, s.includes...)
+	for i := 0; len(values) < length; i++ {
+		sample := generateSample(s.elementSchema, depth)
+		if s.unique || s.sortedUnique {
+			sample = offsetSample(s.elementSchema, sample, i)
+		}
+		values = append(values, sample)
+	}
+	return values
+}
+
+// offsetSample moves a numeric sample of schema up by index steps, so Unique and SortedUnique arrays
+// get distinct, increasing elements; a step is the schema's Step or MultipleOf when set, and 1 otherwise
+// Other samples are returned unchanged
+func offsetSample(schema Schema, sample any, index int) any {
+	switch s := schema.(type) {
+	case *IntSchema:
+		v, ok := sample.(int)
+		if !ok {
+			return sample
+		}
+		step := int64(1)
+		if s.step != nil {
+			step = *s.step
+		} else if s.multipleOf != nil && *s.multipleOf != 0 {
+			step = *s.multipleOf
+		}
+		if step < 0 {
+			step = -step
+		}
+		return v + index*int(step)
+	case *FloatSchema:
+		v, ok := sample.(float64)
+		if !ok {
+			return sample
+		}
+		step := 1.0
+		if s.step != nil {
+			step = *s.step
+		} else if s.multipleOf != nil && *s.multipleOf != 0 {
+			step = *s.multipleOf
+		}
+		return v + float64(index)*math.Abs(step)
+	case *BigIntSchema:
+		if v, ok := sample.(*big.Int); ok {
+			return new(big.Int).Add(v, big.NewInt(int64(index)))
+		}
+	}
+	return sample
+}
+
+// sampleShape returns a map with a sample for every field of shape
+func sampleShape(shape map[string]Schema, depth int) map[string]any {
+	values := make(map[string]any, len(shape))
+	for key, schema := range shape {
+		values[key] = generateSample(schema, depth)
+	}
+	return values
+}
@@ -0,0 +1,78 @@
+package gozod
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGenerateSample_Valid(t *testing.T) {
+	schemas := map[string]Schema{
+		"plain string":  String(),
+		"short string":  String().Max(3),
+		"long string":   String().Min(12).StartsWith("id_").EndsWith("_x"),
+		"email":         String().Email(),
+		"phone":         String().Phone("GB"),
+		"datetime":      String().Datetime(),
+		"duration":      String().Duration().MinDuration(time.Hour),
+		"nanoid":        String().NanoID(30),
+		"int bounds":    Int().Min(18).Max(120),
+		"int exclusive": Int().GreaterThan(5).LessThan(7),
+		"int negative":  Int().Negative().MultipleOf(5),
+		"int step":      Int().Min(3).Step(4),
+		"float":         Float().Positive().Max(0.5),
+		"bigint":        BigInt().Positive(),
+		"date":          Date().Min(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)),
+		"bool":          Bool(),
+		"array":         Array(Int().Positive()).Min(3).Max(5),
+		"includes":      Array(String()).Includes("admin"),
+		"unique":        Array(Int()).Min(3).Unique(),
+		"sorted unique": Array(Int()).Min(3).SortedUnique(),
+		"unique step":   Array(Int().Min(3).Step(4)).Min(3).Unique(),
+		"unique float":  Array(Float().MultipleOf(0.5)).Min(3).SortedUnique(),
+		"union":         Union(Int().Min(1), String()),
+		"literal":       Literal("on"),
+		"map": Map(map[string]Schema{
+			"name": String().Min(2),
+			"tags": Array(String()).NonEmpty(),
+			"address": Map(map[string]Schema{
+				"zip": String().Regex(`^.*$`),
+			}),
+		}),
+	}
+	for name, schema := range schemas {
+		sample := GenerateSample(schema)
+		if err := schema.Validate(sample, nil); err != nil {
+			t.Errorf("%s: sample %#v is invalid: %v", name, sample, err)
+		}
+	}
+}
+
+func TestGenerateSample_Choices(t *testing.T) {
+	if sample := GenerateSample(String().OneOf("red", "green")); sample != "red" {
+		t.Errorf("Expected the first option, got %v", sample)
+	}
+	if sample := GenerateSample(Int().Example(42)); sample != 42 {
+		t.Errorf("Expected the example, got %v", sample)
+	}
+	if sample := GenerateSample(Array(String()).Min(4)).([]any); len(sample) != 4 {
+		t.Errorf("Expected 4 elements, got %v", sample)
+	}
+}
+
+func TestGenerateSample_Recursive(t *testing.T) {
+	var node Schema
+	node = Map(map[string]Schema{
+		"value":    Int(),
+		"children": Array(Lazy(func() Schema { return node })),
+	})
+
+	// Lazy schemas are resolved up to a fixed depth, so the sample is finite
+	sample := GenerateSample(node)
+	depth := 0
+	for current, ok := sample.(map[string]any); ok; current, ok = current["children"].([]any)[0].(map[string]any) {
+		depth++
+	}
+	if depth != maxSampleDepth+1 {
+		t.Errorf("Expected %d levels, got %d", maxSampleDepth+1, depth)
+	}
+}
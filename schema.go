@@ -1,8 +1,17 @@
 package gozod
 
+import (
+	"context"
+	"fmt"
+)
+
 // Schema is the base interface for all schemas
+// Validate is equivalent to ValidateCtx with context.Background()
+// Validation never modifies a schema, so a fully built schema may be shared across goroutines;
+// builder methods (Min, Refine, ...) mutate in place and must not run concurrently with validation
 type Schema interface {
 	Validate(value any, path []any) *ValidationErrors
+	ValidateCtx(ctx context.Context, value any, path []any) *ValidationErrors
 	Type() string
 }
 
@@ -19,14 +28,51 @@ type CustomErrorFunc func(path []any, code, defaultMessage string) string
 // The second return value is the error message (optional, can be empty string)
 type RefineFunc func(value any) (bool, string)
 
+// RefineOption changes when a refinement added with Refine runs
+type RefineOption int
+
+const (
+	// OnlyIfValid runs the refinement only if no errors were recorded for the value so far,
+	// by built-in checks, nested schemas or earlier refinements
+	// Use it for refinements that assume the value is otherwise valid, to avoid cascading errors
+	OnlyIfValid RefineOption = iota + 1
+
+	// AbortOnFailure skips the remaining refinements of the schema, including async and super refinements,
+	// when the refinement fails
+	AbortOnFailure
+)
+
+// refinement is a refine function with the options it was added with
+type refinement struct {
+	fn          RefineFunc
+	onlyIfValid bool
+	abort       bool
+}
+
 // SuperRefineContext provides methods to add validation errors with custom paths and codes
 // Similar to Zod's superRefine context, allowing fine-grained control over error reporting
 type SuperRefineContext struct {
+	ctx       context.Context
 	errors    *ValidationErrors
 	basePath  []any
 	formatter CustomErrorFunc
 }
 
+// Context returns the context passed to ValidateCtx (context.Background() for Validate)
+func (ctx *SuperRefineContext) Context() context.Context {
+	return ctx.ctx
+}
+
+// Value returns the per-call value stored under key in ValidateOptions.Context
+// It returns nil if no options were passed or the key is not set
+func (ctx *SuperRefineContext) Value(key string) any {
+	opts := optionsFromContext(ctx.ctx)
+	if opts == nil {
+		return nil
+	}
+	return opts.Context[key]
+}
+
 // AddIssue adds a validation error with a custom path, code, and message
 // The path is relative to the base path of the schema being validated
 func (ctx *SuperRefineContext) AddIssue(path []any, code, message string) {
@@ -46,12 +92,69 @@ func (ctx *SuperRefineContext) AddIssueWithMeta(path []any, code, message string
 	ctx.errors.AddWithMeta(fullPath, code, message, meta)
 }
 
+// HasAnyErrors reports whether validation has already recorded any errors
+// This includes errors from child schemas and earlier refinements on the same node
+func (ctx *SuperRefineContext) HasAnyErrors() bool {
+	return len(ctx.errors.Errors) > 0
+}
+
+// HasErrorsAt reports whether an error has already been recorded at path or below it
+// The path is relative to the base path of the schema being validated
+func (ctx *SuperRefineContext) HasErrorsAt(path []any) bool {
+	fullPath := ctx.basePath
+	for _, part := range path {
+		fullPath = PathAppend(fullPath, part)
+	}
+	for _, err := range ctx.errors.Errors {
+		if len(err.Path) >= len(fullPath) && PathEqual(err.Path[:len(fullPath)], fullPath) {
+			return true
+		}
+	}
+	return false
+}
+
 // SuperRefineFunc is a function type for super refinement validation
 // Provides access to a context object for adding errors with custom paths and codes
 type SuperRefineFunc func(value any, ctx *SuperRefineContext)
 
+// AsyncRefineFunc is a function type for context-aware refinement
+// It receives the validation context so long-running checks can honor cancellation and deadlines
+// Returns true if validation passes, false otherwise, plus an optional error message
+type AsyncRefineFunc func(ctx context.Context, value any) (bool, string)
+
+// ParentRefineFunc is a function type for refinements that read sibling fields
+// parent is the map or struct being validated by the enclosing Map or Struct schema
+// Returns true if validation passes, false otherwise, plus an optional error message
+type ParentRefineFunc func(value, parent any) (bool, string)
+
+// parentRefiner is implemented by every schema that embeds BaseSchema
+// Map and Struct use it to run a field's parent refinements with the object being validated
+type parentRefiner interface {
+	hasParentRefinements() bool
+	parentRefinementErrors(value, parent any, path []any) *ValidationErrors
+}
+
 // getErrorMessage returns the custom error message if set, otherwise returns the default
+// The default is the global message registered with SetDefaultMessage, if any, or defaultMessage
 func (b *BaseSchema) getErrorMessage(path []any, code, defaultMessage string) string {
+	if msg, ok := lookupDefaultMessage(code); ok {
+		defaultMessage = msg
+	}
+	return b.formatErrorMessage(path, code, defaultMessage)
+}
+
+// getErrorMessageWithMeta is getErrorMessage for errors that carry meta
+// Placeholders like {minimum} in custom and global messages are replaced with the matching meta values
+func (b *BaseSchema) getErrorMessageWithMeta(path []any, code, defaultMessage string, meta map[string]any) string {
+	msg := b.getErrorMessage(path, code, defaultMessage)
+	if msg == defaultMessage {
+		return msg
+	}
+	return interpolateMessage(msg, meta)
+}
+
+// formatErrorMessage applies the schema's error formatter or custom message for code to defaultMessage
+func (b *BaseSchema) formatErrorMessage(path []any, code, defaultMessage string) string {
 	if b.errorFormatter != nil {
 		return b.errorFormatter(path, code, defaultMessage)
 	}
@@ -65,34 +168,109 @@ func (b *BaseSchema) getErrorMessage(path []any, code, defaultMessage string) st
 
 // addRefinement adds a refinement function to the schema
 // This is a helper method to avoid code duplication across schema types
-func (b *BaseSchema) addRefinement(validator RefineFunc) {
-	if b.refinements == nil {
-		b.refinements = make([]RefineFunc, 0)
+func (b *BaseSchema) addRefinement(validator RefineFunc, opts ...RefineOption) {
+	r := refinement{fn: validator}
+	for _, opt := range opts {
+		switch opt {
+		case OnlyIfValid:
+			r.onlyIfValid = true
+		case AbortOnFailure:
+			r.abort = true
+		default:
+			panic(fmt.Sprintf("gozod: unknown RefineOption %d", opt))
+		}
 	}
-	b.refinements = append(b.refinements, validator)
+	b.refinements = append(b.refinements, r)
 }
 
 // applyRefinements applies all refine functions to the value
 // Returns errors if any refinement fails
+// A failed AbortOnFailure refinement marks errors as aborted, which skips the remaining refinements
 func (b *BaseSchema) applyRefinements(value any, path []any, errors *ValidationErrors) {
 	if len(b.refinements) == 0 {
 		return
 	}
 
 	for _, refine := range b.refinements {
-		valid, message := refine(value)
+		if errors.aborted {
+			return
+		}
+		if refine.onlyIfValid && errors.HasErrors() {
+			continue
+		}
+		valid, message := refine.fn(value)
 		if !valid {
-			if message == "" {
-				message = b.getErrorMessage(path, ErrCodeCustomValidation, "Custom validation failed")
-			} else {
-				// Use the custom message but still use the error code
-				message = b.getErrorMessage(path, ErrCodeCustomValidation, message)
-			}
-			errors.Add(path, ErrCodeCustomValidation, message)
+			errors.Add(path, ErrCodeCustomValidation, b.refinementMessage(path, message))
+			errors.aborted = refine.abort
 		}
 	}
 }
 
+// refinementMessage returns the error message for a failed refinement
+// A message returned by the refinement takes precedence over the global default for ErrCodeCustomValidation,
+// but custom errors and formatters set on the schema still apply to it
+func (b *BaseSchema) refinementMessage(path []any, message string) string {
+	if message == "" {
+		return b.getErrorMessage(path, ErrCodeCustomValidation, "Custom validation failed")
+	}
+	return b.formatErrorMessage(path, ErrCodeCustomValidation, message)
+}
+
+// addAsyncRefinement adds an async refinement function to the schema
+func (b *BaseSchema) addAsyncRefinement(validator AsyncRefineFunc) {
+	if b.asyncRefinements == nil {
+		b.asyncRefinements = make([]AsyncRefineFunc, 0)
+	}
+	b.asyncRefinements = append(b.asyncRefinements, validator)
+}
+
+// applyAsyncRefinements applies all async refine functions to the value
+// If the context is already done, a single ErrCodeCanceled error is added instead
+func (b *BaseSchema) applyAsyncRefinements(ctx context.Context, value any, path []any, errors *ValidationErrors) {
+	if len(b.asyncRefinements) == 0 || errors.aborted {
+		return
+	}
+
+	for _, refine := range b.asyncRefinements {
+		if err := ctx.Err(); err != nil {
+			msg := b.getErrorMessage(path, ErrCodeCanceled, fmt.Sprintf("Validation canceled: %v", err))
+			errors.Add(path, ErrCodeCanceled, msg)
+			return
+		}
+		valid, message := refine(ctx, value)
+		if !valid {
+			errors.Add(path, ErrCodeCustomValidation, b.refinementMessage(path, message))
+		}
+	}
+}
+
+// addParentRefinement adds a parent-aware refinement function to the schema
+func (b *BaseSchema) addParentRefinement(validator ParentRefineFunc) {
+	b.parentRefinements = append(b.parentRefinements, validator)
+}
+
+// hasParentRefinements reports whether any parent-aware refinements are attached
+func (b *BaseSchema) hasParentRefinements() bool {
+	return len(b.parentRefinements) > 0
+}
+
+// parentRefinementErrors applies all parent-aware refine functions to the value
+// It is called by Map and Struct after the field itself validated without errors
+func (b *BaseSchema) parentRefinementErrors(value, parent any, path []any) *ValidationErrors {
+	if len(b.parentRefinements) == 0 {
+		return nil
+	}
+
+	var errors ValidationErrors
+	for _, refine := range b.parentRefinements {
+		valid, message := refine(value, parent)
+		if !valid {
+			errors.Add(path, ErrCodeCustomValidation, b.refinementMessage(path, message))
+		}
+	}
+	return errors.orNil()
+}
+
 // addSuperRefinement adds a super refinement function to the schema
 func (b *BaseSchema) addSuperRefinement(validator SuperRefineFunc) {
 	if b.superRefinements == nil {
@@ -102,27 +280,64 @@ func (b *BaseSchema) addSuperRefinement(validator SuperRefineFunc) {
 }
 
 // applySuperRefinements applies all super refine functions to the value
-func (b *BaseSchema) applySuperRefinements(value any, path []any, errors *ValidationErrors) {
-	if len(b.superRefinements) == 0 {
+func (b *BaseSchema) applySuperRefinements(ctx context.Context, value any, path []any, errors *ValidationErrors) {
+	if len(b.superRefinements) == 0 || errors.aborted {
 		return
 	}
 
+	// The context gets its own collection so that errors does not escape to the heap;
+	// it starts from the accumulated errors and its additions are copied back afterwards
+	collected := &ValidationErrors{Errors: errors.Errors}
 	for _, superRefine := range b.superRefinements {
-		ctx := &SuperRefineContext{
-			errors:    errors,
+		refineCtx := &SuperRefineContext{
+			ctx:       ctx,
+			errors:    collected,
 			basePath:  path,
 			formatter: b.errorFormatter,
 		}
-		superRefine(value, ctx)
+		superRefine(value, refineCtx)
 	}
+	errors.Errors = collected.Errors
 }
 
 // BaseSchema provides common functionality for all schemas
 type BaseSchema struct {
-	required         bool
-	nilable          bool
-	customErrors     map[string]string // Map of error code to custom message
-	errorFormatter   func(path []any, code, defaultMessage string) string
-	refinements      []RefineFunc      // Custom validation refinements
-	superRefinements []SuperRefineFunc // Super refinement validations
+	required          bool
+	nilable           bool
+	customErrors      map[string]string // Map of error code to custom message
+	errorFormatter    func(path []any, code, defaultMessage string) string
+	refinements       []refinement       // Custom validation refinements
+	superRefinements  []SuperRefineFunc  // Super refinement validations
+	asyncRefinements  []AsyncRefineFunc  // Context-aware refinement validations
+	parentRefinements []ParentRefineFunc // Refinements run by Map and Struct with the enclosing object
+	refinementNames   []string           // Names of registered refinements in use
+	annotations       Annotations        // Documentation metadata, ignored by validation
+}
+
+// Annotations holds documentation metadata attached to a schema with Title, Describe and Example
+// Annotations do not affect validation; they are meant for generated docs and JSON Schema output
+type Annotations struct {
+	Title       string
+	Description string
+	Examples    []any
+}
+
+// IsOptional reports whether missing values are allowed, as set by Optional
+func (b *BaseSchema) IsOptional() bool {
+	return !b.required
+}
+
+// IsNilable reports whether null is a valid value, as set by Nilable
+func (b *BaseSchema) IsNilable() bool {
+	return b.nilable
+}
+
+// allowsNil reports whether a nil value passes, either because the schema is nilable or optional
+func (b *BaseSchema) allowsNil() bool {
+	return b.nilable || !b.required
+}
+
+// Annotations returns the documentation metadata attached to the schema
+func (b *BaseSchema) Annotations() Annotations {
+	return b.annotations
 }
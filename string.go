@@ -1,9 +1,19 @@
 package gozod
 
 import (
+	"context"
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"net"
+	"reflect"
 	"regexp"
+	"slices"
 	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 )
 
 // StringSchema validates string values
@@ -11,15 +21,85 @@ type StringSchema struct {
 	BaseSchema
 	minLength    *int
 	maxLength    *int
+	length       *int
+	graphemes    bool // Count length in grapheme clusters instead of runes
+	minBytes     *int
+	maxBytes     *int
+	nonEmpty     bool
+	nonEmptyTrim bool // Trim whitespace before the NonEmpty check, set by NonBlank
+	nonEmptyMsg  string
 	email        bool
 	url          bool
 	regex        *regexp.Regexp
 	regexMessage string
+	notRegex     *regexp.Regexp
+	notRegexMsg  string
+	patterns     []*regexp.Regexp // Set by OneOfPattern; the string must match at least one
 	oneOf        []string
 	notOneOf     []string
 	startsWith   *string
 	endsWith     *string
 	includes     *string
+	// Case-insensitive variants of startsWith, endsWith and includes
+	startsWithFold bool
+	endsWithFold   bool
+	includesFold   bool
+	datetime       *string // Go time layout the string must parse with
+	dateOnly       bool    // ISO 8601 calendar date, e.g. "2024-01-15"
+	timeOnly       bool    // ISO 8601 time of day, e.g. "13:45:00"
+	duration       bool    // Go duration string, e.g. "1h30m"
+	minDuration    *time.Duration
+	maxDuration    *time.Duration
+	base64         bool
+	base64URL      bool
+	hex            *HexOptions
+	hashBits       int
+	phone          *string // Region code, or "" for E.164
+	creditCard     bool
+	mac            *MACOptions
+	cidrs          []*net.IPNet // Networks set by InCIDR; the IP must fall within one of them
+	hostname       bool
+	fqdn           bool
+	nanoIDLength   int // Expected NanoID length, 0 when not validated
+	cuid2          bool
+	ulid           bool
+	coerce         bool // Convert numbers and bools to their string form
+	acceptStringer bool // Convert encoding.TextMarshaler and fmt.Stringer values to strings
+	emoji          bool
+	ascii          bool
+	printable      bool
+	password       *PasswordOptions
+}
+
+// HexOptions configures hexadecimal string validation
+type HexOptions struct {
+	EvenLength  bool // Require an even number of digits (whole bytes)
+	AllowPrefix bool // Accept an optional "0x" or "0X" prefix
+}
+
+// MACOptions configures MAC address validation
+type MACOptions struct {
+	AllowEUI64 bool // Also accept 64-bit EUI-64 addresses
+}
+
+// PasswordOptions configures the requirements checked by Password
+// Zero fields are not checked; lengths are counted in runes
+type PasswordOptions struct {
+	MinLength     int
+	MaxLength     int
+	RequireUpper  bool // At least one uppercase letter
+	RequireLower  bool // At least one lowercase letter
+	RequireDigit  bool // At least one decimal digit
+	RequireSymbol bool // At least one punctuation or symbol character
+}
+
+// DefaultPasswordOptions are the requirements used by Password when no options are given
+var DefaultPasswordOptions = PasswordOptions{
+	MinLength:     8,
+	RequireUpper:  true,
+	RequireLower:  true,
+	RequireDigit:  true,
+	RequireSymbol: true,
 }
 
 // String creates a new string schema
@@ -35,18 +115,82 @@ func (s *StringSchema) Nilable() *StringSchema {
 	return s
 }
 
-// Min sets the minimum length
+// Optional allows missing values without declaring null a valid value
+// Validation accepts nil as with Nilable; the difference shows in IsOptional and IsNilable
+func (s *StringSchema) Optional() *StringSchema {
+	s.required = false
+	return s
+}
+
+// Min sets the minimum length in characters
+// Characters are runes, or grapheme clusters with CountGraphemes
 func (s *StringSchema) Min(length int) *StringSchema {
 	s.minLength = &length
 	return s
 }
 
-// Max sets the maximum length
+// Max sets the maximum length in characters
 func (s *StringSchema) Max(length int) *StringSchema {
 	s.maxLength = &length
 	return s
 }
 
+// Between sets the minimum and maximum length at once
+// It panics if min is greater than max
+func (s *StringSchema) Between(min, max int) *StringSchema {
+	if min > max {
+		panic(fmt.Sprintf("gozod: Between requires min <= max, got %v and %v", min, max))
+	}
+	s.minLength = &min
+	s.maxLength = &max
+	return s
+}
+
+// Length sets the exact length in characters
+func (s *StringSchema) Length(length int) *StringSchema {
+	s.length = &length
+	return s
+}
+
+// CountGraphemes makes Min, Max, Between and Length count grapheme clusters instead of runes,
+// so that combined emoji like "👨‍👩‍👧" or "🇯🇵" and letters with combining accents count as one character
+func (s *StringSchema) CountGraphemes() *StringSchema {
+	s.graphemes = true
+	return s
+}
+
+// MinBytes sets the minimum length in bytes of the UTF-8 encoding, independent of Min and CountGraphemes
+func (s *StringSchema) MinBytes(n int) *StringSchema {
+	s.minBytes = &n
+	return s
+}
+
+// MaxBytes sets the maximum length in bytes of the UTF-8 encoding, e.g. for storage limits like VARCHAR(255) bytes
+func (s *StringSchema) MaxBytes(n int) *StringSchema {
+	s.maxBytes = &n
+	return s
+}
+
+// NonEmpty validates that the string is not empty, failing with a clearer message than Min(1)
+// The raw string is checked, so whitespace-only strings pass; use NonBlank to reject them
+// An optional message replaces the default "String must not be empty"
+func (s *StringSchema) NonEmpty(message ...string) *StringSchema {
+	s.nonEmpty = true
+	if len(message) > 0 {
+		s.nonEmptyMsg = message[0]
+	}
+	return s
+}
+
+// NonBlank is like NonEmpty but trims leading and trailing whitespace before the check,
+// so strings of only spaces, tabs or newlines fail too; the value itself is not modified
+// An optional message replaces the default "String must not be blank"
+func (s *StringSchema) NonBlank(message ...string) *StringSchema {
+	s.NonEmpty(message...)
+	s.nonEmptyTrim = true
+	return s
+}
+
 // Email validates email format
 func (s *StringSchema) Email() *StringSchema {
 	s.email = true
@@ -65,6 +209,15 @@ func (s *StringSchema) Regex(pattern string, message ...string) *StringSchema {
 	if err != nil {
 		panic(fmt.Sprintf("invalid regex pattern: %s", err))
 	}
+	return s.RegexCompiled(regex, message...)
+}
+
+// RegexCompiled validates against an already compiled regular expression
+// Use it to share a *regexp.Regexp across schemas without compiling the pattern again
+func (s *StringSchema) RegexCompiled(regex *regexp.Regexp, message ...string) *StringSchema {
+	if regex == nil {
+		panic("gozod: RegexCompiled requires a non-nil *regexp.Regexp")
+	}
 	s.regex = regex
 	if len(message) > 0 {
 		s.regexMessage = message[0]
@@ -72,12 +225,55 @@ func (s *StringSchema) Regex(pattern string, message ...string) *StringSchema {
 	return s
 }
 
+// NotRegex fails when the string matches the regular expression
+// Use it for blocklist-style checks, since Go's regexp has no negative lookahead
+func (s *StringSchema) NotRegex(pattern string, message ...string) *StringSchema {
+	regex, err := regexp.Compile(pattern)
+	if err != nil {
+		panic(fmt.Sprintf("invalid regex pattern: %s", err))
+	}
+	s.notRegex = regex
+	if len(message) > 0 {
+		s.notRegexMsg = message[0]
+	}
+	return s
+}
+
+// RegexE is like Regex but returns the compile error instead of panicking
+// On error the schema is left unchanged
+func (s *StringSchema) RegexE(pattern string, message ...string) (*StringSchema, error) {
+	regex, err := regexp.Compile(pattern)
+	if err != nil {
+		return s, fmt.Errorf("invalid regex pattern: %w", err)
+	}
+	return s.RegexCompiled(regex, message...), nil
+}
+
 // OneOf validates that the value is one of the provided options
 func (s *StringSchema) OneOf(options ...string) *StringSchema {
 	s.oneOf = options
 	return s
 }
 
+// OneOfPattern validates that the string matches at least one of the regular expressions,
+// e.g. to accept several ID formats without writing one large alternation
+// It panics if no pattern is given or a pattern does not compile
+func (s *StringSchema) OneOfPattern(patterns ...string) *StringSchema {
+	if len(patterns) == 0 {
+		panic("gozod: OneOfPattern requires at least one pattern")
+	}
+	compiled := make([]*regexp.Regexp, len(patterns))
+	for i, pattern := range patterns {
+		regex, err := regexp.Compile(pattern)
+		if err != nil {
+			panic(fmt.Sprintf("invalid regex pattern: %s", err))
+		}
+		compiled[i] = regex
+	}
+	s.patterns = compiled
+	return s
+}
+
 // NotOneOf validates that the value is not one of the provided options
 func (s *StringSchema) NotOneOf(options ...string) *StringSchema {
 	s.notOneOf = options
@@ -87,18 +283,268 @@ func (s *StringSchema) NotOneOf(options ...string) *StringSchema {
 // StartsWith validates that the string starts with the given prefix
 func (s *StringSchema) StartsWith(prefix string) *StringSchema {
 	s.startsWith = &prefix
+	s.startsWithFold = false
+	return s
+}
+
+// StartsWithInsensitive validates that the string starts with the given prefix, ignoring case
+func (s *StringSchema) StartsWithInsensitive(prefix string) *StringSchema {
+	s.startsWith = &prefix
+	s.startsWithFold = true
 	return s
 }
 
 // EndsWith validates that the string ends with the given suffix
 func (s *StringSchema) EndsWith(suffix string) *StringSchema {
 	s.endsWith = &suffix
+	s.endsWithFold = false
+	return s
+}
+
+// EndsWithInsensitive validates that the string ends with the given suffix, ignoring case
+func (s *StringSchema) EndsWithInsensitive(suffix string) *StringSchema {
+	s.endsWith = &suffix
+	s.endsWithFold = true
 	return s
 }
 
 // Includes validates that the string includes the given substring
 func (s *StringSchema) Includes(substring string) *StringSchema {
 	s.includes = &substring
+	s.includesFold = false
+	return s
+}
+
+// IncludesInsensitive validates that the string contains the given substring, ignoring case
+func (s *StringSchema) IncludesInsensitive(substring string) *StringSchema {
+	s.includes = &substring
+	s.includesFold = true
+	return s
+}
+
+// Datetime validates that the string is a timestamp in the given Go time layout
+// Without a layout, time.RFC3339 is used (e.g. "2024-01-15T13:45:00Z")
+func (s *StringSchema) Datetime(layout ...string) *StringSchema {
+	format := time.RFC3339
+	if len(layout) > 0 {
+		format = layout[0]
+	}
+	s.datetime = &format
+	return s
+}
+
+// Date validates that the string is an ISO 8601 calendar date (layout "2006-01-02", e.g. "2024-01-15")
+func (s *StringSchema) Date() *StringSchema {
+	s.dateOnly = true
+	return s
+}
+
+// Time validates that the string is an ISO 8601 time of day (layout "15:04:05", e.g. "13:45:00")
+// Fractional seconds are accepted (e.g. "13:45:00.250"); hours, minutes and seconds need two digits
+func (s *StringSchema) Time() *StringSchema {
+	s.timeOnly = true
+	return s
+}
+
+// Duration validates that the string is a Go duration accepted by time.ParseDuration (e.g. "30s", "1h30m")
+func (s *StringSchema) Duration() *StringSchema {
+	s.duration = true
+	return s
+}
+
+// MinDuration validates that the string is a duration of at least min
+// It implies Duration
+func (s *StringSchema) MinDuration(min time.Duration) *StringSchema {
+	s.duration = true
+	s.minDuration = &min
+	return s
+}
+
+// MaxDuration validates that the string is a duration of at most max
+// It implies Duration
+func (s *StringSchema) MaxDuration(max time.Duration) *StringSchema {
+	s.duration = true
+	s.maxDuration = &max
+	return s
+}
+
+// Base64 validates that the string is standard, padded base64 (base64.StdEncoding)
+func (s *StringSchema) Base64() *StringSchema {
+	s.base64 = true
+	return s
+}
+
+// Base64URL validates that the string is unpadded URL-safe base64 (base64.RawURLEncoding)
+func (s *StringSchema) Base64URL() *StringSchema {
+	s.base64URL = true
+	return s
+}
+
+// Hex validates that the string contains only hexadecimal digits
+// An optional HexOptions can require whole bytes or accept a "0x" prefix
+func (s *StringSchema) Hex(opts ...HexOptions) *StringSchema {
+	options := HexOptions{}
+	if len(opts) > 0 {
+		options = opts[0]
+	}
+	s.hex = &options
+	return s
+}
+
+// Hash validates that the string is a hex digest of the given size in bits,
+// e.g. Hash(256) for SHA-256 (64 hex characters)
+func (s *StringSchema) Hash(bits int) *StringSchema {
+	if bits <= 0 || bits%4 != 0 {
+		panic(fmt.Sprintf("gozod: hash size must be a positive multiple of 4 bits, got %d", bits))
+	}
+	s.hashBits = bits
+	return s
+}
+
+// Phone validates a phone number
+// Without a region the string must be in E.164 format (e.g. "+14155552671")
+// With a region (e.g. "US", "GB") national formats with common separators are accepted too
+// It panics if the region is not supported
+func (s *StringSchema) Phone(region ...string) *StringSchema {
+	code := ""
+	if len(region) > 0 {
+		code = strings.ToUpper(region[0])
+		if _, ok := phoneRegions[code]; !ok {
+			panic(fmt.Sprintf("gozod: unsupported phone region %q", region[0]))
+		}
+	}
+	s.phone = &code
+	return s
+}
+
+// CreditCard validates a payment card number
+// Spaces and dashes are ignored; the rest must be 13-19 digits that pass the Luhn checksum
+// When the brand is recognized it is included in the error Meta under "brand"
+func (s *StringSchema) CreditCard() *StringSchema {
+	s.creditCard = true
+	return s
+}
+
+// MAC validates a colon- or hyphen-separated MAC address (e.g. "00:1a:2b:3c:4d:5e")
+// Only 48-bit addresses are accepted unless MACOptions.AllowEUI64 is set
+func (s *StringSchema) MAC(opts ...MACOptions) *StringSchema {
+	options := MACOptions{}
+	if len(opts) > 0 {
+		options = opts[0]
+	}
+	s.mac = &options
+	return s
+}
+
+// InCIDR validates that the string is an IP address within one of the given networks,
+// written in CIDR notation (e.g. "10.0.0.0/8" or "2001:db8::/32")
+// It panics if no network is given or a network does not parse
+func (s *StringSchema) InCIDR(cidrs ...string) *StringSchema {
+	if len(cidrs) == 0 {
+		panic("gozod: InCIDR requires at least one network")
+	}
+	networks := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(fmt.Sprintf("gozod: InCIDR network %q is invalid: %v", cidr, err))
+		}
+		networks[i] = network
+	}
+	s.cidrs = networks
+	return s
+}
+
+// Hostname validates an RFC 1123 hostname (e.g. "db-1" or "api.example.com")
+// Labels are alphanumerics and hyphens, 1-63 characters, with no leading or trailing hyphen;
+// the whole name is at most 253 characters and may end with a single root dot
+func (s *StringSchema) Hostname() *StringSchema {
+	s.hostname = true
+	return s
+}
+
+// FQDN validates a fully qualified domain name: a hostname with at least two labels
+// whose final label looks like a top-level domain (letters only, or a punycode "xn--" label)
+func (s *StringSchema) FQDN() *StringSchema {
+	s.fqdn = true
+	return s
+}
+
+// NanoID validates a NanoID with the default URL-safe alphabet (A-Z, a-z, 0-9, "_" and "-")
+// The length defaults to 21 characters
+func (s *StringSchema) NanoID(length ...int) *StringSchema {
+	s.nanoIDLength = 21
+	if len(length) > 0 {
+		if length[0] <= 0 {
+			panic(fmt.Sprintf("gozod: NanoID length must be positive, got %d", length[0]))
+		}
+		s.nanoIDLength = length[0]
+	}
+	return s
+}
+
+// CUID2 validates a CUID2: a lowercase letter followed by lowercase letters and digits,
+// 2 to 32 characters in total (24 by default when generated)
+func (s *StringSchema) CUID2() *StringSchema {
+	s.cuid2 = true
+	return s
+}
+
+// ULID validates a 26-character ULID in Crockford base32 (e.g. "01ARZ3NDEKTSV4RRFFQ69G5FAV")
+// The characters I, L, O and U are rejected; the timestamp portion is not checked
+func (s *StringSchema) ULID() *StringSchema {
+	s.ulid = true
+	return s
+}
+
+// Emoji validates that the string is composed solely of emoji
+// ZWJ sequences, flags, skin tone modifiers and keycaps count as emoji; the empty string does not
+func (s *StringSchema) Emoji() *StringSchema {
+	s.emoji = true
+	return s
+}
+
+// ASCII validates that the string only contains ASCII characters (U+0000 to U+007F)
+func (s *StringSchema) ASCII() *StringSchema {
+	s.ascii = true
+	return s
+}
+
+// Printable validates that the string only contains printable ASCII characters (space to tilde)
+// Control characters such as tabs and newlines are rejected
+func (s *StringSchema) Printable() *StringSchema {
+	s.printable = true
+	return s
+}
+
+// Password validates the string against a set of password requirements,
+// using DefaultPasswordOptions when no options are given
+// Each unmet requirement adds its own error with Meta {"constraint": "password", "requirement": ...},
+// so a form can show which rules still fail
+func (s *StringSchema) Password(opts ...PasswordOptions) *StringSchema {
+	options := DefaultPasswordOptions
+	if len(opts) > 0 {
+		options = opts[0]
+	}
+	if options.MinLength < 0 || options.MaxLength < 0 || (options.MaxLength > 0 && options.MaxLength < options.MinLength) {
+		panic(fmt.Sprintf("gozod: invalid password length bounds %d-%d", options.MinLength, options.MaxLength))
+	}
+	s.password = &options
+	return s
+}
+
+// Coerce converts numbers, bools and json.Number values to strings with fmt.Sprint before validation
+// Other non-string values still fail with ErrCodeInvalidType; Parse returns the coerced string
+func (s *StringSchema) Coerce() *StringSchema {
+	s.coerce = true
+	return s
+}
+
+// AcceptStringer accepts values implementing encoding.TextMarshaler or fmt.Stringer, such as custom domain types
+// Plain strings are used as they are; otherwise MarshalText is preferred over String
+// A MarshalText error fails with ErrCodeInvalidType; Parse returns the converted string
+func (s *StringSchema) AcceptStringer() *StringSchema {
+	s.acceptStringer = true
 	return s
 }
 
@@ -106,8 +552,16 @@ func (s *StringSchema) Includes(substring string) *StringSchema {
 // The function receives the value and returns (isValid, errorMessage)
 // If isValid is false, validation fails with the provided errorMessage
 // If errorMessage is empty, a default message will be used
-func (s *StringSchema) Refine(validator RefineFunc) *StringSchema {
-	s.BaseSchema.addRefinement(validator)
+// Options such as OnlyIfValid and AbortOnFailure control when it runs
+func (s *StringSchema) Refine(validator RefineFunc, opts ...RefineOption) *StringSchema {
+	s.BaseSchema.addRefinement(validator, opts...)
+	return s
+}
+
+// UseRefinement attaches a refinement registered with RegisterRefinement
+// It panics if no refinement is registered under name
+func (s *StringSchema) UseRefinement(name string) *StringSchema {
+	s.BaseSchema.useRefinement(name)
 	return s
 }
 
@@ -119,38 +573,79 @@ func (s *StringSchema) SuperRefine(validator SuperRefineFunc) *StringSchema {
 	return s
 }
 
+// AsyncRefine adds a context-aware validation function
+// The function receives the context passed to ValidateCtx and is awaited before validation returns
+// Use it for expensive checks (e.g. database lookups) that need cancellation or deadlines
+func (s *StringSchema) AsyncRefine(validator AsyncRefineFunc) *StringSchema {
+	s.BaseSchema.addAsyncRefinement(validator)
+	return s
+}
+
+// RefineWithParent adds a validation function that also receives the enclosing object
+// It runs when the schema validates a field of a Map or Struct and the field itself is valid,
+// including a nil value on a Nilable field, which makes it suitable for conditionally required fields
+func (s *StringSchema) RefineWithParent(validator ParentRefineFunc) *StringSchema {
+	s.BaseSchema.addParentRefinement(validator)
+	return s
+}
+
+// Or returns a union that accepts values matching either s or other
+func (s *StringSchema) Or(other Schema) *UnionSchema {
+	return Union(s, other)
+}
+
+// And returns an intersection that requires values to match both s and other
+func (s *StringSchema) And(other Schema) *IntersectionSchema {
+	return Intersection(s, other)
+}
+
 // Validate validates a value against the string schema
 func (s *StringSchema) Validate(value any, path []any) *ValidationErrors {
-	errors := &ValidationErrors{}
+	return s.ValidateCtx(context.Background(), value, path)
+}
+
+// ValidateCtx validates a value using ctx for cancellation and request scoping
+func (s *StringSchema) ValidateCtx(ctx context.Context, value any, path []any) *ValidationErrors {
+	// Errors are collected in a local value and only escape to the heap when validation fails
+	var errors ValidationErrors
 
 	// Handle nil/nilable
 	// Only nilable allows explicit nil values
 	if value == nil {
-		if !s.nilable {
+		if !s.allowsNil() {
 			msg := s.getErrorMessage(path, ErrCodeRequired, "Required")
 			errors.Add(path, ErrCodeRequired, msg)
-			return errors
+			return errors.orNil()
 		}
 		return nil
 	}
 
+	value, err := s.convert(value)
+	if err != nil {
+		msg := s.getErrorMessage(path, ErrCodeInvalidType, fmt.Sprintf("Failed to convert %T to text: %v", value, err))
+		errors.Add(path, ErrCodeInvalidType, msg)
+		return errors.orNil()
+	}
+
 	// Type check
 	str, ok := value.(string)
 	if !ok {
 		msg := s.getErrorMessage(path, ErrCodeInvalidType, fmt.Sprintf("Expected string, got %T", value))
 		errors.Add(path, ErrCodeInvalidType, msg)
-		return errors
+		return errors.orNil()
 	}
 
 	// Length validations
-	if s.minLength != nil && len(str) < *s.minLength {
-		msg := s.getErrorMessage(path, ErrCodeTooSmall, fmt.Sprintf("String must be at least %d character(s) long, got %d", *s.minLength, len(str)))
-		errors.Add(path, ErrCodeTooSmall, msg)
+	if s.nonEmpty {
+		s.validateNonEmpty(str, path, &errors)
 	}
 
-	if s.maxLength != nil && len(str) > *s.maxLength {
-		msg := s.getErrorMessage(path, ErrCodeTooBig, fmt.Sprintf("String must be at most %d character(s) long, got %d", *s.maxLength, len(str)))
-		errors.Add(path, ErrCodeTooBig, msg)
+	if s.minLength != nil || s.maxLength != nil || s.length != nil {
+		s.validateLength(s.textLength(str), path, &errors)
+	}
+
+	if s.minBytes != nil || s.maxBytes != nil {
+		s.validateBytes(len(str), path, &errors)
 	}
 
 	// Email validation
@@ -180,6 +675,20 @@ func (s *StringSchema) Validate(value any, path []any) *ValidationErrors {
 		errors.Add(path, ErrCodeInvalidString, message)
 	}
 
+	// NotRegex validation
+	if s.notRegex != nil && s.notRegex.MatchString(str) {
+		message := s.notRegexMsg
+		if message == "" {
+			message = s.getErrorMessage(path, ErrCodeInvalidString, "String matches a forbidden pattern")
+		}
+		errors.Add(path, ErrCodeInvalidString, message)
+	}
+
+	// OneOfPattern validation
+	if len(s.patterns) > 0 {
+		s.validatePatterns(str, path, &errors)
+	}
+
 	// OneOf validation
 	if len(s.oneOf) > 0 {
 		found := false
@@ -207,33 +716,549 @@ func (s *StringSchema) Validate(value any, path []any) *ValidationErrors {
 	}
 
 	// StartsWith validation
-	if s.startsWith != nil && !strings.HasPrefix(str, *s.startsWith) {
+	if s.startsWith != nil && !hasPrefix(str, *s.startsWith, s.startsWithFold) {
 		msg := s.getErrorMessage(path, ErrCodeInvalidString, fmt.Sprintf("String must start with '%s'", *s.startsWith))
 		errors.Add(path, ErrCodeInvalidString, msg)
 	}
 
 	// EndsWith validation
-	if s.endsWith != nil && !strings.HasSuffix(str, *s.endsWith) {
+	if s.endsWith != nil && !hasSuffix(str, *s.endsWith, s.endsWithFold) {
 		msg := s.getErrorMessage(path, ErrCodeInvalidString, fmt.Sprintf("String must end with '%s'", *s.endsWith))
 		errors.Add(path, ErrCodeInvalidString, msg)
 	}
 
 	// Includes validation
-	if s.includes != nil && !strings.Contains(str, *s.includes) {
+	if s.includes != nil && !contains(str, *s.includes, s.includesFold) {
 		msg := s.getErrorMessage(path, ErrCodeInvalidString, fmt.Sprintf("String must include '%s'", *s.includes))
 		errors.Add(path, ErrCodeInvalidString, msg)
 	}
 
+	// Datetime validation
+	if s.datetime != nil {
+		if _, err := time.Parse(*s.datetime, str); err != nil {
+			meta := map[string]any{"format": *s.datetime}
+			msg := s.getErrorMessageWithMeta(path, ErrCodeInvalidString, fmt.Sprintf("Invalid datetime, expected format %s", *s.datetime), meta)
+			errors.AddWithMeta(path, ErrCodeInvalidString, msg, meta)
+		}
+	}
+
+	// Date validation
+	if s.dateOnly {
+		if _, err := time.Parse(time.DateOnly, str); err != nil {
+			meta := map[string]any{"format": "date"}
+			msg := s.getErrorMessageWithMeta(path, ErrCodeInvalidString, "Invalid date, expected format YYYY-MM-DD", meta)
+			errors.AddWithMeta(path, ErrCodeInvalidString, msg, meta)
+		}
+	}
+
+	// Time validation
+	if s.timeOnly && !isTimeOfDay(str) {
+		meta := map[string]any{"format": "time"}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeInvalidString, "Invalid time, expected format HH:MM:SS", meta)
+		errors.AddWithMeta(path, ErrCodeInvalidString, msg, meta)
+	}
+
+	// Duration validation
+	if s.duration {
+		s.validateDuration(str, path, &errors)
+	}
+
+	// Base64 validation
+	if s.base64 {
+		if _, err := base64.StdEncoding.DecodeString(str); err != nil {
+			msg := s.getErrorMessage(path, ErrCodeInvalidString, "Invalid base64 string")
+			errors.Add(path, ErrCodeInvalidString, msg)
+		}
+	}
+
+	// Base64URL validation
+	if s.base64URL {
+		if _, err := base64.RawURLEncoding.DecodeString(str); err != nil {
+			msg := s.getErrorMessage(path, ErrCodeInvalidString, "Invalid base64url string")
+			errors.Add(path, ErrCodeInvalidString, msg)
+		}
+	}
+
+	// Hex validation
+	if s.hex != nil {
+		digits := str
+		if s.hex.AllowPrefix && (strings.HasPrefix(digits, "0x") || strings.HasPrefix(digits, "0X")) {
+			digits = digits[2:]
+		}
+		if digits == "" || !isHexDigits(digits) {
+			msg := s.getErrorMessage(path, ErrCodeInvalidString, "Invalid hexadecimal string")
+			errors.Add(path, ErrCodeInvalidString, msg)
+		} else if s.hex.EvenLength && len(digits)%2 != 0 {
+			msg := s.getErrorMessage(path, ErrCodeInvalidString, "Hexadecimal string must have an even number of digits")
+			errors.Add(path, ErrCodeInvalidString, msg)
+		}
+	}
+
+	// Hash validation
+	if s.hashBits > 0 && (len(str) != s.hashBits/4 || !isHexDigits(str)) {
+		msg := s.getErrorMessage(path, ErrCodeInvalidString, fmt.Sprintf("String must be a %d-bit hex hash (%d hex characters)", s.hashBits, s.hashBits/4))
+		errors.Add(path, ErrCodeInvalidString, msg)
+	}
+
+	// Phone validation
+	if s.phone != nil && !isValidPhone(str, *s.phone) {
+		if *s.phone == "" {
+			msg := s.getErrorMessage(path, ErrCodeInvalidString, "Invalid phone number, expected E.164 format")
+			errors.Add(path, ErrCodeInvalidString, msg)
+		} else {
+			meta := map[string]any{"region": *s.phone}
+			msg := s.getErrorMessageWithMeta(path, ErrCodeInvalidString, fmt.Sprintf("Invalid phone number for region %s", *s.phone), meta)
+			errors.AddWithMeta(path, ErrCodeInvalidString, msg, meta)
+		}
+	}
+
+	// CreditCard validation
+	if s.creditCard {
+		if digits, ok := normalizeCardNumber(str); !ok || !luhnValid(digits) {
+			var meta map[string]any
+			if brand := CardBrand(digits); brand != "" {
+				meta = map[string]any{"brand": brand}
+			}
+			msg := s.getErrorMessageWithMeta(path, ErrCodeInvalidString, "Invalid credit card number", meta)
+			errors.AddWithMeta(path, ErrCodeInvalidString, msg, meta)
+		}
+	}
+
+	// MAC validation
+	if s.mac != nil && !isValidMAC(str, *s.mac) {
+		msg := s.getErrorMessage(path, ErrCodeInvalidString, "Invalid MAC address")
+		errors.Add(path, ErrCodeInvalidString, msg)
+	}
+
+	// CIDR validation
+	if len(s.cidrs) > 0 {
+		s.validateCIDR(str, path, &errors)
+	}
+
+	// Hostname validation
+	if s.hostname && !isValidHostname(str, false) {
+		msg := s.getErrorMessage(path, ErrCodeInvalidString, "Invalid hostname")
+		errors.Add(path, ErrCodeInvalidString, msg)
+	}
+
+	// FQDN validation
+	if s.fqdn && !isValidHostname(str, true) {
+		msg := s.getErrorMessage(path, ErrCodeInvalidString, "Invalid fully qualified domain name")
+		errors.Add(path, ErrCodeInvalidString, msg)
+	}
+
+	// NanoID validation
+	if s.nanoIDLength > 0 && !isNanoID(str, s.nanoIDLength) {
+		msg := s.getErrorMessage(path, ErrCodeInvalidString, fmt.Sprintf("Invalid NanoID, expected %d URL-safe characters", s.nanoIDLength))
+		errors.Add(path, ErrCodeInvalidString, msg)
+	}
+
+	// CUID2 validation
+	if s.cuid2 && !isCUID2(str) {
+		msg := s.getErrorMessage(path, ErrCodeInvalidString, "Invalid CUID2")
+		errors.Add(path, ErrCodeInvalidString, msg)
+	}
+
+	// ULID validation
+	if s.ulid && !isULID(str) {
+		msg := s.getErrorMessage(path, ErrCodeInvalidString, "Invalid ULID")
+		errors.Add(path, ErrCodeInvalidString, msg)
+	}
+
+	// Emoji validation
+	if s.emoji && !isEmoji(str) {
+		msg := s.getErrorMessage(path, ErrCodeInvalidString, "String must only contain emoji")
+		errors.Add(path, ErrCodeInvalidString, msg)
+	}
+
+	// ASCII validation
+	if s.ascii && !isASCII(str) {
+		msg := s.getErrorMessage(path, ErrCodeInvalidString, "String must only contain ASCII characters")
+		errors.Add(path, ErrCodeInvalidString, msg)
+	}
+
+	// Printable validation
+	if s.printable && !isPrintableASCII(str) {
+		msg := s.getErrorMessage(path, ErrCodeInvalidString, "String must only contain printable ASCII characters")
+		errors.Add(path, ErrCodeInvalidString, msg)
+	}
+
+	// Password validation
+	if s.password != nil {
+		s.validatePassword(str, path, &errors)
+	}
+
 	// Apply custom refinements (only if type check passed)
-	s.applyRefinements(value, path, errors)
+	s.applyRefinements(value, path, &errors)
+
+	// Apply async refinements (only if type check passed)
+	s.applyAsyncRefinements(ctx, value, path, &errors)
 
 	// Apply super refinements (only if type check passed)
-	s.applySuperRefinements(value, path, errors)
+	s.applySuperRefinements(ctx, value, path, &errors)
 
-	if len(errors.Errors) == 0 {
-		return nil
+	return errors.orNil()
+}
+
+// hasPrefix is strings.HasPrefix, optionally ignoring case
+func hasPrefix(str, prefix string, fold bool) bool {
+	if !fold {
+		return strings.HasPrefix(str, prefix)
+	}
+	return len(str) >= len(prefix) && strings.EqualFold(str[:len(prefix)], prefix)
+}
+
+// hasSuffix is strings.HasSuffix, optionally ignoring case
+func hasSuffix(str, suffix string, fold bool) bool {
+	if !fold {
+		return strings.HasSuffix(str, suffix)
+	}
+	return len(str) >= len(suffix) && strings.EqualFold(str[len(str)-len(suffix):], suffix)
+}
+
+// contains is strings.Contains, optionally ignoring case
+func contains(str, substring string, fold bool) bool {
+	if !fold {
+		return strings.Contains(str, substring)
+	}
+	return strings.Contains(strings.ToLower(str), strings.ToLower(substring))
+}
+
+// validateNonEmpty checks the NonEmpty and NonBlank constraints
+func (s *StringSchema) validateNonEmpty(str string, path []any, errors *ValidationErrors) {
+	constraint, defaultMessage := "nonEmpty", "String must not be empty"
+	if s.nonEmptyTrim {
+		constraint, defaultMessage = "nonBlank", "String must not be blank"
+		str = strings.TrimSpace(str)
+	}
+	if str != "" {
+		return
+	}
+	message := s.nonEmptyMsg
+	meta := map[string]any{"constraint": constraint, "minimum": 1, "actual": 0}
+	if message == "" {
+		message = s.getErrorMessageWithMeta(path, ErrCodeTooSmall, defaultMessage, meta)
+	}
+	errors.AddWithMeta(path, ErrCodeTooSmall, message, meta)
+}
+
+// textLength returns the length of str in runes, or in grapheme clusters with CountGraphemes
+func (s *StringSchema) textLength(str string) int {
+	if s.graphemes {
+		return graphemeCount(str)
+	}
+	return utf8.RuneCountInString(str)
+}
+
+// validateLength checks the Min, Max and Length constraints against the length of the string
+func (s *StringSchema) validateLength(length int, path []any, errors *ValidationErrors) {
+	if s.minLength != nil && length < *s.minLength {
+		meta := map[string]any{"minimum": *s.minLength, "actual": length}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeTooSmall, fmt.Sprintf("String must be at least %d character(s) long, got %d", *s.minLength, length), meta)
+		errors.AddWithMeta(path, ErrCodeTooSmall, msg, meta)
+	}
+
+	if s.maxLength != nil && length > *s.maxLength {
+		meta := map[string]any{"maximum": *s.maxLength, "actual": length}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeTooBig, fmt.Sprintf("String must be at most %d character(s) long, got %d", *s.maxLength, length), meta)
+		errors.AddWithMeta(path, ErrCodeTooBig, msg, meta)
 	}
-	return errors
+
+	if s.length != nil && length != *s.length {
+		code, meta := ErrCodeTooSmall, map[string]any{"minimum": *s.length, "exact": true, "actual": length}
+		if length > *s.length {
+			code, meta = ErrCodeTooBig, map[string]any{"maximum": *s.length, "exact": true, "actual": length}
+		}
+		msg := s.getErrorMessageWithMeta(path, code, fmt.Sprintf("String must be exactly %d character(s) long, got %d", *s.length, length), meta)
+		errors.AddWithMeta(path, code, msg, meta)
+	}
+}
+
+// validatePatterns checks that str matches at least one of the OneOfPattern expressions
+func (s *StringSchema) validatePatterns(str string, path []any, errors *ValidationErrors) {
+	for _, regex := range s.patterns {
+		if regex.MatchString(str) {
+			return
+		}
+	}
+	patterns := make([]string, len(s.patterns))
+	for i, regex := range s.patterns {
+		patterns[i] = regex.String()
+	}
+	meta := map[string]any{"constraint": "oneOfPattern", "patterns": patterns}
+	msg := s.getErrorMessageWithMeta(path, ErrCodeInvalidString, fmt.Sprintf("String must match one of the patterns: %s", strings.Join(patterns, ", ")), meta)
+	errors.AddWithMeta(path, ErrCodeInvalidString, msg, meta)
+}
+
+// validateCIDR checks that str is an IP address within one of the InCIDR networks
+func (s *StringSchema) validateCIDR(str string, path []any, errors *ValidationErrors) {
+	ip := net.ParseIP(str)
+	if ip == nil {
+		meta := map[string]any{"format": "ip"}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeInvalidString, "Invalid IP address", meta)
+		errors.AddWithMeta(path, ErrCodeInvalidString, msg, meta)
+		return
+	}
+	for _, network := range s.cidrs {
+		if network.Contains(ip) {
+			return
+		}
+	}
+	cidrs := make([]string, len(s.cidrs))
+	for i, network := range s.cidrs {
+		cidrs[i] = network.String()
+	}
+	meta := map[string]any{"constraint": "inCIDR", "cidrs": cidrs}
+	msg := s.getErrorMessageWithMeta(path, ErrCodeInvalidString, fmt.Sprintf("IP address must be within %s", strings.Join(cidrs, ", ")), meta)
+	errors.AddWithMeta(path, ErrCodeInvalidString, msg, meta)
+}
+
+// passwordClasses lists the character classes Password can require, in the order they are reported
+var passwordClasses = []struct {
+	requirement string
+	message     string
+	required    func(PasswordOptions) bool
+	matches     func(rune) bool
+}{
+	{"uppercase", "Password must contain an uppercase letter", func(o PasswordOptions) bool { return o.RequireUpper }, unicode.IsUpper},
+	{"lowercase", "Password must contain a lowercase letter", func(o PasswordOptions) bool { return o.RequireLower }, unicode.IsLower},
+	{"digit", "Password must contain a digit", func(o PasswordOptions) bool { return o.RequireDigit }, unicode.IsDigit},
+	{"symbol", "Password must contain a symbol", func(o PasswordOptions) bool { return o.RequireSymbol }, func(r rune) bool {
+		return unicode.IsPunct(r) || unicode.IsSymbol(r)
+	}},
+}
+
+// validatePassword adds one error for each Password requirement str does not meet
+func (s *StringSchema) validatePassword(str string, path []any, errors *ValidationErrors) {
+	opts := *s.password
+	length := utf8.RuneCountInString(str)
+	if opts.MinLength > 0 && length < opts.MinLength {
+		meta := map[string]any{"constraint": "password", "requirement": "minLength", "minimum": opts.MinLength, "actual": length}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeTooSmall, fmt.Sprintf("Password must be at least %d character(s) long", opts.MinLength), meta)
+		errors.AddWithMeta(path, ErrCodeTooSmall, msg, meta)
+	}
+	if opts.MaxLength > 0 && length > opts.MaxLength {
+		meta := map[string]any{"constraint": "password", "requirement": "maxLength", "maximum": opts.MaxLength, "actual": length}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeTooBig, fmt.Sprintf("Password must be at most %d character(s) long", opts.MaxLength), meta)
+		errors.AddWithMeta(path, ErrCodeTooBig, msg, meta)
+	}
+
+	for _, class := range passwordClasses {
+		if !class.required(opts) || strings.IndexFunc(str, class.matches) >= 0 {
+			continue
+		}
+		meta := map[string]any{"constraint": "password", "requirement": class.requirement}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeInvalidString, class.message, meta)
+		errors.AddWithMeta(path, ErrCodeInvalidString, msg, meta)
+	}
+}
+
+// validateBytes checks the MinBytes and MaxBytes constraints against the size of the string in bytes
+func (s *StringSchema) validateBytes(size int, path []any, errors *ValidationErrors) {
+	if s.minBytes != nil && size < *s.minBytes {
+		meta := map[string]any{"constraint": "minBytes", "minimum": *s.minBytes, "actual": size}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeTooSmall, fmt.Sprintf("String must be at least %d byte(s) long, got %d", *s.minBytes, size), meta)
+		errors.AddWithMeta(path, ErrCodeTooSmall, msg, meta)
+	}
+
+	if s.maxBytes != nil && size > *s.maxBytes {
+		meta := map[string]any{"constraint": "maxBytes", "maximum": *s.maxBytes, "actual": size}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeTooBig, fmt.Sprintf("String must be at most %d byte(s) long, got %d", *s.maxBytes, size), meta)
+		errors.AddWithMeta(path, ErrCodeTooBig, msg, meta)
+	}
+}
+
+// validateDuration checks that str parses as a duration within the configured bounds
+func (s *StringSchema) validateDuration(str string, path []any, errors *ValidationErrors) {
+	d, err := time.ParseDuration(str)
+	if err != nil {
+		meta := map[string]any{"format": "duration"}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeInvalidString, "Invalid duration, expected a value like 30s or 1h30m", meta)
+		errors.AddWithMeta(path, ErrCodeInvalidString, msg, meta)
+		return
+	}
+	if s.minDuration != nil && d < *s.minDuration {
+		meta := map[string]any{"minimum": *s.minDuration, "actual": d}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeTooSmall, fmt.Sprintf("Duration must be at least %s, got %s", *s.minDuration, d), meta)
+		errors.AddWithMeta(path, ErrCodeTooSmall, msg, meta)
+	}
+	if s.maxDuration != nil && d > *s.maxDuration {
+		meta := map[string]any{"maximum": *s.maxDuration, "actual": d}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeTooBig, fmt.Sprintf("Duration must be at most %s, got %s", *s.maxDuration, d), meta)
+		errors.AddWithMeta(path, ErrCodeTooBig, msg, meta)
+	}
+}
+
+// timeOfDayRegex matches HH:MM:SS with optional fractional seconds; ranges are checked by time.Parse
+var timeOfDayRegex = regexp.MustCompile(`^\d{2}:\d{2}:\d{2}(\.\d{1,9})?$`)
+
+// isTimeOfDay reports whether str is a valid ISO 8601 time of day such as "13:45:00" or "13:45:00.250"
+func isTimeOfDay(str string) bool {
+	if !timeOfDayRegex.MatchString(str) {
+		return false
+	}
+	_, err := time.Parse(time.TimeOnly, str)
+	return err == nil
+}
+
+// isValidMAC reports whether str is a colon- or hyphen-separated MAC address
+// net.ParseMAC also accepts dot-separated and 20-octet forms, which are rejected here
+func isValidMAC(str string, opts MACOptions) bool {
+	if len(str) < 3 || (str[2] != ':' && str[2] != '-') {
+		return false
+	}
+	hw, err := net.ParseMAC(str)
+	if err != nil {
+		return false
+	}
+	return len(hw) == 6 || (opts.AllowEUI64 && len(hw) == 8)
+}
+
+// isValidHostname reports whether str follows RFC 1123 hostname rules
+// With fqdn set it also requires two or more labels and a TLD-like final label
+func isValidHostname(str string, fqdn bool) bool {
+	str = strings.TrimSuffix(str, ".")
+	if str == "" || len(str) > 253 {
+		return false
+	}
+
+	labels := strings.Split(str, ".")
+	for _, label := range labels {
+		if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for i := 0; i < len(label); i++ {
+			c := label[i]
+			if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '-') {
+				return false
+			}
+		}
+	}
+
+	if !fqdn {
+		return true
+	}
+	if len(labels) < 2 {
+		return false
+	}
+	tld := labels[len(labels)-1]
+	if strings.HasPrefix(strings.ToLower(tld), "xn--") {
+		return true
+	}
+	if len(tld) < 2 {
+		return false
+	}
+	for i := 0; i < len(tld); i++ {
+		c := tld[i]
+		if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+// isHexDigits reports whether str consists only of hexadecimal digits
+func isHexDigits(str string) bool {
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+			return false
+		}
+	}
+	return true
+}
+
+// parse validates value and returns it, converted to string if Coerce or AcceptStringer is set
+func (s *StringSchema) parse(ctx context.Context, value any, path []any) (any, *ValidationErrors) {
+	if converted, err := s.convert(value); err == nil {
+		value = converted
+	}
+	if errs := s.ValidateCtx(ctx, value, path); errs != nil {
+		return nil, errs
+	}
+	return value, nil
+}
+
+// convert applies the conversions enabled by Coerce and AcceptStringer to value
+func (s *StringSchema) convert(value any) (any, error) {
+	if s.coerce {
+		value = coerceString(value)
+	}
+	if s.acceptStringer {
+		return stringerValue(value)
+	}
+	return value, nil
+}
+
+// stringerValue converts encoding.TextMarshaler and fmt.Stringer values to strings
+// Plain strings, nil pointers and other values are returned unchanged
+func stringerValue(value any) (any, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case encoding.TextMarshaler, fmt.Stringer:
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && rv.IsNil() {
+			return value, nil
+		}
+	}
+	switch v := value.(type) {
+	case encoding.TextMarshaler:
+		text, err := v.MarshalText()
+		if err != nil {
+			return value, err
+		}
+		return string(text), nil
+	case fmt.Stringer:
+		return v.String(), nil
+	}
+	return value, nil
+}
+
+// coerceString converts numbers, bools and json.Number values to their string form
+// Other values are returned unchanged so the type check reports them
+func coerceString(value any) any {
+	switch v := value.(type) {
+	case string, nil:
+		return value
+	case json.Number:
+		return string(v)
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Bool || numericKind(rv.Kind()) != 0 {
+		return fmt.Sprint(value)
+	}
+	return value
+}
+
+// Title sets a short human-readable title used in generated documentation
+func (s *StringSchema) Title(title string) *StringSchema {
+	s.annotations.Title = title
+	return s
+}
+
+// Describe sets a description used in generated documentation
+// It does not affect validation
+func (s *StringSchema) Describe(description string) *StringSchema {
+	s.annotations.Description = description
+	return s
+}
+
+// Example adds an example value used in generated documentation
+func (s *StringSchema) Example(example any) *StringSchema {
+	s.annotations.Examples = append(s.annotations.Examples, example)
+	return s
+}
+
+// Clone returns an independent copy of the schema
+// Constraints, refinements and custom errors are copied, so changing the clone does not affect s
+func (s *StringSchema) Clone() *StringSchema {
+	c := *s
+	c.BaseSchema = s.BaseSchema.clone()
+	c.oneOf = slices.Clone(s.oneOf)
+	c.notOneOf = slices.Clone(s.notOneOf)
+	c.patterns = slices.Clone(s.patterns)
+	c.cidrs = slices.Clone(s.cidrs)
+	return &c
 }
 
 // CustomError sets a custom error message for a specific error code
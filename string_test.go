@@ -1,7 +1,13 @@
 package gozod
 
 import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestStringSchema_Required(t *testing.T) {
@@ -498,3 +504,865 @@ func TestStringSchema_Refine(t *testing.T) {
 		}
 	}
 }
+
+func TestStringSchema_Datetime(t *testing.T) {
+	schema := String().Datetime()
+
+	valid := []string{"2024-01-15T13:45:00Z", "2024-01-15T13:45:00.123+02:00"}
+	for _, value := range valid {
+		if err := schema.Validate(value, nil); err != nil {
+			t.Errorf("Expected no errors for %q, got: %v", value, err)
+		}
+	}
+
+	invalid := []string{"2024-01-15", "2024-01-15 13:45:00", "not a date", "2024-13-01T00:00:00Z"}
+	for _, value := range invalid {
+		err := schema.Validate(value, nil)
+		if err == nil {
+			t.Errorf("Expected error for %q", value)
+			continue
+		}
+		if err.Errors[0].Code != ErrCodeInvalidString {
+			t.Errorf("Expected error code %s, got %s", ErrCodeInvalidString, err.Errors[0].Code)
+		}
+	}
+}
+
+func TestStringSchema_Datetime_CustomLayout(t *testing.T) {
+	schema := String().Datetime("2006-01-02 15:04")
+
+	if err := schema.Validate("2024-01-15 13:45", nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+	if err := schema.Validate("2024-01-15T13:45:00Z", nil); err == nil {
+		t.Error("Expected error for RFC3339 value with custom layout")
+	}
+}
+
+func TestStringSchema_Base64(t *testing.T) {
+	schema := String().Base64()
+
+	for _, value := range []string{"aGVsbG8=", "aGVsbG8gd29ybGQ=", "", "+/+/"} {
+		if err := schema.Validate(value, nil); err != nil {
+			t.Errorf("Expected no errors for %q, got: %v", value, err)
+		}
+	}
+	for _, value := range []string{"aGVsbG8", "not base64!", "-_-_"} {
+		err := schema.Validate(value, nil)
+		if err == nil {
+			t.Errorf("Expected error for %q", value)
+			continue
+		}
+		if err.Errors[0].Code != ErrCodeInvalidString {
+			t.Errorf("Expected error code %s, got %s", ErrCodeInvalidString, err.Errors[0].Code)
+		}
+	}
+}
+
+func TestStringSchema_Base64URL(t *testing.T) {
+	schema := String().Base64URL()
+
+	for _, value := range []string{"aGVsbG8", "-_-_", ""} {
+		if err := schema.Validate(value, nil); err != nil {
+			t.Errorf("Expected no errors for %q, got: %v", value, err)
+		}
+	}
+	for _, value := range []string{"aGVsbG8=", "+/+/", "a b"} {
+		if err := schema.Validate(value, nil); err == nil {
+			t.Errorf("Expected error for %q", value)
+		}
+	}
+}
+
+func TestStringSchema_Hex(t *testing.T) {
+	schema := String().Hex()
+
+	for _, value := range []string{"deadBEEF", "0", "abc"} {
+		if err := schema.Validate(value, nil); err != nil {
+			t.Errorf("Expected no errors for %q, got: %v", value, err)
+		}
+	}
+	for _, value := range []string{"", "xyz", "0xff", "12 34"} {
+		err := schema.Validate(value, nil)
+		if err == nil {
+			t.Errorf("Expected error for %q", value)
+			continue
+		}
+		if err.Errors[0].Code != ErrCodeInvalidString {
+			t.Errorf("Expected error code %s, got %s", ErrCodeInvalidString, err.Errors[0].Code)
+		}
+	}
+}
+
+func TestStringSchema_Hex_Options(t *testing.T) {
+	schema := String().Hex(HexOptions{EvenLength: true, AllowPrefix: true})
+
+	for _, value := range []string{"0xff", "0XABCD", "00ff"} {
+		if err := schema.Validate(value, nil); err != nil {
+			t.Errorf("Expected no errors for %q, got: %v", value, err)
+		}
+	}
+	for _, value := range []string{"0xfff", "abc", "0x"} {
+		if err := schema.Validate(value, nil); err == nil {
+			t.Errorf("Expected error for %q", value)
+		}
+	}
+}
+
+func TestStringSchema_Hash(t *testing.T) {
+	schema := String().Hash(256)
+
+	sha256 := "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	if err := schema.Validate(sha256, nil); err != nil {
+		t.Errorf("Expected no errors for sha256 digest, got: %v", err)
+	}
+	if err := schema.Validate(sha256[:40], nil); err == nil {
+		t.Error("Expected error for 160-bit digest")
+	}
+	if err := schema.Validate("g"+sha256[1:], nil); err == nil {
+		t.Error("Expected error for non-hex character")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for invalid hash size")
+		}
+	}()
+	String().Hash(6)
+}
+
+func TestStringSchema_Phone(t *testing.T) {
+	schema := String().Phone()
+
+	for _, value := range []string{"+14155552671", "+442071838750", "+4930123456"} {
+		if err := schema.Validate(value, nil); err != nil {
+			t.Errorf("Expected no errors for %q, got: %v", value, err)
+		}
+	}
+	for _, value := range []string{"14155552671", "+0123456789", "+1 415 555 2671", "+1234567890123456", "+"} {
+		err := schema.Validate(value, nil)
+		if err == nil || err.Errors[0].Code != ErrCodeInvalidString {
+			t.Errorf("Expected %s error for %q, got: %v", ErrCodeInvalidString, value, err)
+		}
+	}
+}
+
+func TestStringSchema_Phone_Region(t *testing.T) {
+	us := String().Phone("US")
+	for _, value := range []string{"(415) 555-2671", "415.555.2671", "1-415-555-2671", "+1 415 555 2671"} {
+		if err := us.Validate(value, nil); err != nil {
+			t.Errorf("Expected no errors for %q, got: %v", value, err)
+		}
+	}
+	for _, value := range []string{"015 555 2671", "415-555-267", "+44 20 7183 8750"} {
+		if err := us.Validate(value, nil); err == nil {
+			t.Errorf("Expected error for %q", value)
+		}
+	}
+
+	gb := String().Phone("gb")
+	for _, value := range []string{"020 7183 8750", "+44 20 7183 8750", "07911 123456"} {
+		if err := gb.Validate(value, nil); err != nil {
+			t.Errorf("Expected no errors for %q, got: %v", value, err)
+		}
+	}
+	err := gb.Validate("020 7183", nil)
+	if err == nil {
+		t.Fatal("Expected error for short number")
+	}
+	if err.Errors[0].Meta["region"] != "GB" {
+		t.Errorf("Expected region meta GB, got: %v", err.Errors[0].Meta)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for unsupported region")
+		}
+	}()
+	String().Phone("XX")
+}
+
+func TestStringSchema_CreditCard(t *testing.T) {
+	schema := String().CreditCard()
+
+	for _, value := range []string{"4111111111111111", "4111 1111 1111 1111", "5500-0000-0000-0004", "378282246310005"} {
+		if err := schema.Validate(value, nil); err != nil {
+			t.Errorf("Expected no errors for %q, got: %v", value, err)
+		}
+	}
+	for _, value := range []string{"411111111111", "4111a11111111111", "12345678901234567890", ""} {
+		err := schema.Validate(value, nil)
+		if err == nil || err.Errors[0].Code != ErrCodeInvalidString {
+			t.Errorf("Expected %s error for %q, got: %v", ErrCodeInvalidString, value, err)
+		}
+	}
+
+	// Luhn failure keeps the detected brand in Meta
+	err := schema.Validate("4111111111111112", nil)
+	if err == nil {
+		t.Fatal("Expected error for bad checksum")
+	}
+	if err.Errors[0].Meta["brand"] != "visa" {
+		t.Errorf("Expected brand visa in meta, got: %v", err.Errors[0].Meta)
+	}
+}
+
+func TestCardBrand(t *testing.T) {
+	cases := map[string]string{
+		"4111111111111111": "visa",
+		"5500000000000004": "mastercard",
+		"2221000000000009": "mastercard",
+		"378282246310005":  "amex",
+		"6011111111111117": "discover",
+		"30569309025904":   "diners",
+		"3530111333300000": "jcb",
+		"6200000000000005": "unionpay",
+		"9999999999999995": "",
+	}
+	for number, want := range cases {
+		if got := CardBrand(number); got != want {
+			t.Errorf("CardBrand(%q) = %q, want %q", number, got, want)
+		}
+	}
+}
+
+func TestStringSchema_MAC(t *testing.T) {
+	schema := String().MAC()
+
+	for _, value := range []string{"00:1a:2b:3c:4d:5e", "00-1A-2B-3C-4D-5E"} {
+		if err := schema.Validate(value, nil); err != nil {
+			t.Errorf("Expected no errors for %q, got: %v", value, err)
+		}
+	}
+	for _, value := range []string{"001a.2b3c.4d5e", "00:1a:2b:3c:4d", "00:1a:2b:3c:4d:5g", "02:00:5e:10:00:00:00:01", ""} {
+		err := schema.Validate(value, nil)
+		if err == nil || err.Errors[0].Code != ErrCodeInvalidString {
+			t.Errorf("Expected %s error for %q, got: %v", ErrCodeInvalidString, value, err)
+		}
+	}
+
+	eui64 := String().MAC(MACOptions{AllowEUI64: true})
+	for _, value := range []string{"02:00:5e:10:00:00:00:01", "00:1a:2b:3c:4d:5e"} {
+		if err := eui64.Validate(value, nil); err != nil {
+			t.Errorf("Expected no errors for %q, got: %v", value, err)
+		}
+	}
+}
+
+func TestStringSchema_Hostname(t *testing.T) {
+	schema := String().Hostname()
+
+	for _, value := range []string{"localhost", "db-1", "api.example.com", "example.com.", "1host"} {
+		if err := schema.Validate(value, nil); err != nil {
+			t.Errorf("Expected no errors for %q, got: %v", value, err)
+		}
+	}
+	invalid := []string{"", "-db", "db-", "a..b", "host_name", "exa mple.com", strings.Repeat("a", 64) + ".com", strings.Repeat("a.", 127) + "ab"}
+	for _, value := range invalid {
+		err := schema.Validate(value, nil)
+		if err == nil || err.Errors[0].Code != ErrCodeInvalidString {
+			t.Errorf("Expected %s error for %q, got: %v", ErrCodeInvalidString, value, err)
+		}
+	}
+}
+
+func TestStringSchema_FQDN(t *testing.T) {
+	schema := String().FQDN()
+
+	for _, value := range []string{"example.com", "api.example.co.uk", "example.com.", "example.xn--p1ai"} {
+		if err := schema.Validate(value, nil); err != nil {
+			t.Errorf("Expected no errors for %q, got: %v", value, err)
+		}
+	}
+	for _, value := range []string{"localhost", "example.c", "example.123", "-a.com"} {
+		err := schema.Validate(value, nil)
+		if err == nil || err.Errors[0].Code != ErrCodeInvalidString {
+			t.Errorf("Expected %s error for %q, got: %v", ErrCodeInvalidString, value, err)
+		}
+	}
+}
+
+func TestStringSchema_NanoID(t *testing.T) {
+	schema := String().NanoID()
+
+	if err := schema.Validate("V1StGXR8_Z5jdHi6B-myT", nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+	for _, value := range []string{"V1StGXR8_Z5jdHi6B-my", "V1StGXR8_Z5jdHi6B-myT1", "V1StGXR8_Z5jdHi6B+myT"} {
+		err := schema.Validate(value, nil)
+		if err == nil || err.Errors[0].Code != ErrCodeInvalidString {
+			t.Errorf("Expected %s error for %q, got: %v", ErrCodeInvalidString, value, err)
+		}
+	}
+
+	if err := String().NanoID(10).Validate("IRFa-VaY2b", nil); err != nil {
+		t.Errorf("Expected no errors for custom length, got: %v", err)
+	}
+}
+
+func TestStringSchema_CUID2(t *testing.T) {
+	schema := String().CUID2()
+
+	if err := schema.Validate("tz4a98xxat96iws9zmbrgj3a", nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+	for _, value := range []string{"Tz4a98xxat96iws9zmbrgj3a", "1z4a98xxat96iws9zmbrgj3a", "tz4a98xx-at96", "a", strings.Repeat("a", 33)} {
+		err := schema.Validate(value, nil)
+		if err == nil || err.Errors[0].Code != ErrCodeInvalidString {
+			t.Errorf("Expected %s error for %q, got: %v", ErrCodeInvalidString, value, err)
+		}
+	}
+}
+
+func TestStringSchema_ULID(t *testing.T) {
+	schema := String().ULID()
+
+	for _, value := range []string{"01ARZ3NDEKTSV4RRFFQ69G5FAV", "01arz3ndektsv4rrffq69g5fav", "7ZZZZZZZZZZZZZZZZZZZZZZZZZ"} {
+		if err := schema.Validate(value, nil); err != nil {
+			t.Errorf("Expected no errors for %q, got: %v", value, err)
+		}
+	}
+	invalid := []string{
+		"01ARZ3NDEKTSV4RRFFQ69G5FA",   // too short
+		"01ARZ3NDEKTSV4RRFFQ69G5FAVX", // too long
+		"01ARZ3NDEKTSV4RRFFQ69G5FAI",  // I
+		"01ARZ3NDEKTSV4RRFFQ69G5FAL",  // L
+		"01ARZ3NDEKTSV4RRFFQ69G5FAO",  // O
+		"01ARZ3NDEKTSV4RRFFQ69G5FAU",  // U
+		"81ARZ3NDEKTSV4RRFFQ69G5FAV",  // overflows 128 bits
+	}
+	for _, value := range invalid {
+		err := schema.Validate(value, nil)
+		if err == nil || err.Errors[0].Code != ErrCodeInvalidString {
+			t.Errorf("Expected %s error for %q, got: %v", ErrCodeInvalidString, value, err)
+		}
+	}
+}
+
+func TestStringSchema_CaseInsensitiveAffixes(t *testing.T) {
+	startsWith := String().StartsWithInsensitive("https://")
+	for _, value := range []string{"https://example.com", "HTTPS://example.com", "Https://example.com"} {
+		if err := startsWith.Validate(value, nil); err != nil {
+			t.Errorf("Expected no errors for %q, got: %v", value, err)
+		}
+	}
+	if err := startsWith.Validate("http://example.com", nil); err == nil {
+		t.Error("Expected error for http:// prefix")
+	}
+	if err := startsWith.Validate("http", nil); err == nil {
+		t.Error("Expected error for input shorter than prefix")
+	}
+
+	endsWith := String().EndsWithInsensitive(".PDF")
+	if err := endsWith.Validate("report.pdf", nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+	if err := endsWith.Validate("report.doc", nil); err == nil {
+		t.Error("Expected error for .doc suffix")
+	}
+
+	includes := String().IncludesInsensitive("bearer")
+	if err := includes.Validate("Authorization: Bearer abc", nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+
+	// Case-sensitive behavior stays the default
+	err := String().StartsWith("https://").Validate("HTTPS://example.com", nil)
+	if err == nil || err.Errors[0].Code != ErrCodeInvalidString {
+		t.Errorf("Expected %s error, got: %v", ErrCodeInvalidString, err)
+	}
+}
+
+func TestStringSchema_RegexCompiled(t *testing.T) {
+	slug := regexp.MustCompile(`^[a-z0-9-]+$`)
+	schema := String().RegexCompiled(slug, "Must be a slug")
+
+	if err := schema.Validate("my-post-1", nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+	err := schema.Validate("My Post", nil)
+	if err == nil || err.Errors[0].Message != "Must be a slug" {
+		t.Errorf("Expected custom message, got: %v", err)
+	}
+}
+
+func TestStringSchema_RegexE(t *testing.T) {
+	schema, err := String().RegexE(`^\d+$`)
+	if err != nil {
+		t.Fatalf("Expected no compile error, got: %v", err)
+	}
+	if errs := schema.Validate("123", nil); errs != nil {
+		t.Errorf("Expected no errors, got: %v", errs)
+	}
+	if errs := schema.Validate("12a", nil); errs == nil {
+		t.Error("Expected error for non-digits")
+	}
+
+	if _, err := String().RegexE(`(unclosed`); err == nil {
+		t.Error("Expected compile error for invalid pattern")
+	}
+}
+
+func TestStringSchema_NotRegex(t *testing.T) {
+	schema := String().NotRegex(`\d{3,}`)
+
+	if err := schema.Validate("pass12word", nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+	err := schema.Validate("pass123word", nil)
+	if err == nil || err.Errors[0].Code != ErrCodeInvalidString {
+		t.Errorf("Expected %s error, got: %v", ErrCodeInvalidString, err)
+	}
+
+	custom := String().NotRegex(`(?i)admin`, "Reserved name")
+	err = custom.Validate("SuperAdmin", nil)
+	if err == nil || err.Errors[0].Message != "Reserved name" {
+		t.Errorf("Expected custom message, got: %v", err)
+	}
+
+	// Regex and NotRegex combine
+	both := String().Regex(`^[a-z0-9]+$`).NotRegex(`^\d+$`)
+	if err := both.Validate("12345", nil); err == nil {
+		t.Error("Expected error for digits only")
+	}
+	if err := both.Validate("abc123", nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+}
+
+func TestStringSchema_Between(t *testing.T) {
+	schema := String().Between(2, 4)
+
+	if err := schema.Validate("abc", nil); err != nil {
+		t.Errorf("Expected no errors for 'abc', got: %v", err)
+	}
+	err := schema.Validate("a", nil)
+	if err == nil || err.Errors[0].Code != ErrCodeTooSmall {
+		t.Errorf("Expected %s error for 'a', got: %v", ErrCodeTooSmall, err)
+	}
+	err = schema.Validate("abcde", nil)
+	if err == nil || err.Errors[0].Code != ErrCodeTooBig {
+		t.Errorf("Expected %s error for 'abcde', got: %v", ErrCodeTooBig, err)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for Between(4, 2)")
+		}
+	}()
+	String().Between(4, 2)
+}
+
+func TestStringSchema_Coerce(t *testing.T) {
+	schema := String().Coerce().Min(2)
+
+	tests := []struct {
+		input    any
+		expected string
+	}{
+		{12345, "12345"},
+		{int64(-7), "-7"},
+		{uint8(42), "42"},
+		{3.5, "3.5"},
+		{true, "true"},
+		{json.Number("9007199254740993"), "9007199254740993"},
+		{"already", "already"},
+	}
+	for _, tt := range tests {
+		got, errs := Parse[string](schema, tt.input)
+		if errs != nil {
+			t.Errorf("Parse(%v): expected no errors, got: %v", tt.input, errs)
+			continue
+		}
+		if got != tt.expected {
+			t.Errorf("Parse(%v) = %q, expected %q", tt.input, got, tt.expected)
+		}
+	}
+
+	// String checks run on the coerced value
+	if err := schema.Validate(7, nil); err == nil || err.Errors[0].Code != ErrCodeTooSmall {
+		t.Errorf("Expected %s error for 7, got: %v", ErrCodeTooSmall, err)
+	}
+
+	// Only scalars are coerced
+	err := schema.Validate([]int{1, 2}, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeInvalidType {
+		t.Errorf("Expected %s error for a slice, got: %v", ErrCodeInvalidType, err)
+	}
+
+	// Without Coerce numbers are rejected
+	if err := String().Validate(12345, nil); err == nil {
+		t.Error("Expected error for number without Coerce")
+	}
+}
+
+func TestStringSchema_Emoji(t *testing.T) {
+	schema := String().Emoji()
+
+	valid := []string{
+		"😀",
+		"👍🏽",    // skin tone modifier
+		"👨‍👩‍👧", // ZWJ sequence
+		"🇳🇴",    // flag
+		"❤️",    // variation selector
+		"1️⃣",   // keycap
+		"🎉🎉🎉",
+	}
+	for _, value := range valid {
+		if err := schema.Validate(value, nil); err != nil {
+			t.Errorf("Expected no errors for %q, got: %v", value, err)
+		}
+	}
+
+	invalid := []string{"", "hello", "😀 ", "1", "a😀", "#", "\u200D", "\uFE0F", "\U0001F3FD", "\u200D\uFE0F"}
+	for _, value := range invalid {
+		err := schema.Validate(value, nil)
+		if err == nil || err.Errors[0].Code != ErrCodeInvalidString {
+			t.Errorf("Expected %s error for %q, got: %v", ErrCodeInvalidString, value, err)
+		}
+	}
+}
+
+func TestStringSchema_ASCIIAndPrintable(t *testing.T) {
+	tests := []struct {
+		value     string
+		ascii     bool
+		printable bool
+	}{
+		{"hello_world-42", true, true},
+		{"with space ~", true, true},
+		{"tab\there", true, false},
+		{"line\n", true, false},
+		{"café", false, false},
+		{"", true, true},
+	}
+	for _, tt := range tests {
+		if err := String().ASCII().Validate(tt.value, nil); (err == nil) != tt.ascii {
+			t.Errorf("ASCII(%q): expected valid=%v, got: %v", tt.value, tt.ascii, err)
+		}
+		err := String().Printable().Validate(tt.value, nil)
+		if (err == nil) != tt.printable {
+			t.Errorf("Printable(%q): expected valid=%v, got: %v", tt.value, tt.printable, err)
+		}
+		if err != nil && err.Errors[0].Code != ErrCodeInvalidString {
+			t.Errorf("Printable(%q): expected %s, got %s", tt.value, ErrCodeInvalidString, err.Errors[0].Code)
+		}
+	}
+}
+
+func TestStringSchema_DateAndTime(t *testing.T) {
+	date := String().Date()
+	for _, value := range []string{"2024-01-15", "2024-02-29"} {
+		if err := date.Validate(value, nil); err != nil {
+			t.Errorf("Expected no errors for date %q, got: %v", value, err)
+		}
+	}
+	for _, value := range []string{"2023-02-29", "2024-1-15", "2024-01-15T00:00:00Z", "15/01/2024", ""} {
+		err := date.Validate(value, nil)
+		if err == nil || err.Errors[0].Code != ErrCodeInvalidString || err.Errors[0].Meta["format"] != "date" {
+			t.Errorf("Expected %s error with format date for %q, got: %v", ErrCodeInvalidString, value, err)
+		}
+	}
+
+	tm := String().Time()
+	for _, value := range []string{"13:45:00", "00:00:00", "23:59:59.999", "08:30:15.123456789"} {
+		if err := tm.Validate(value, nil); err != nil {
+			t.Errorf("Expected no errors for time %q, got: %v", value, err)
+		}
+	}
+	for _, value := range []string{"24:00:00", "13:60:00", "1:45:00", "13:45", "13:45:00Z", "13:45:00."} {
+		err := tm.Validate(value, nil)
+		if err == nil || err.Errors[0].Code != ErrCodeInvalidString || err.Errors[0].Meta["format"] != "time" {
+			t.Errorf("Expected %s error with format time for %q, got: %v", ErrCodeInvalidString, value, err)
+		}
+	}
+}
+
+func TestStringSchema_Duration(t *testing.T) {
+	schema := String().Duration()
+	for _, value := range []string{"30s", "1h30m", "250ms", "-5m", "0"} {
+		if err := schema.Validate(value, nil); err != nil {
+			t.Errorf("Expected no errors for %q, got: %v", value, err)
+		}
+	}
+	for _, value := range []string{"", "30", "1 hour", "5d"} {
+		err := schema.Validate(value, nil)
+		if err == nil || err.Errors[0].Code != ErrCodeInvalidString {
+			t.Errorf("Expected %s error for %q, got: %v", ErrCodeInvalidString, value, err)
+		}
+	}
+
+	bounded := String().MinDuration(time.Second).MaxDuration(time.Minute)
+	if err := bounded.Validate("30s", nil); err != nil {
+		t.Errorf("Expected no errors for 30s, got: %v", err)
+	}
+	err := bounded.Validate("500ms", nil)
+	if err == nil || err.Errors[0].Code != ErrCodeTooSmall {
+		t.Errorf("Expected %s error for 500ms, got: %v", ErrCodeTooSmall, err)
+	}
+	err = bounded.Validate("2m", nil)
+	if err == nil || err.Errors[0].Code != ErrCodeTooBig {
+		t.Errorf("Expected %s error for 2m, got: %v", ErrCodeTooBig, err)
+	}
+	if err.Errors[0].Message != "Duration must be at most 1m0s, got 2m0s" {
+		t.Errorf("Unexpected message: %q", err.Errors[0].Message)
+	}
+}
+
+func TestStringSchema_NonEmpty(t *testing.T) {
+	schema := String().NonEmpty()
+
+	if err := schema.Validate("a", nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+	// The raw string is checked
+	if err := schema.Validate("  ", nil); err != nil {
+		t.Errorf("Expected whitespace to pass, got: %v", err)
+	}
+
+	err := schema.Validate("", nil)
+	if err == nil || err.Errors[0].Code != ErrCodeTooSmall || err.Errors[0].Message != "String must not be empty" {
+		t.Fatalf("Expected non-empty error, got: %v", err)
+	}
+	if err.Errors[0].Meta["constraint"] != "nonEmpty" {
+		t.Errorf("Expected nonEmpty constraint in meta, got: %v", err.Errors[0].Meta)
+	}
+
+	err = String().NonEmpty("Name is required").Validate("", nil)
+	if err == nil || err.Errors[0].Message != "Name is required" {
+		t.Errorf("Expected custom message, got: %v", err)
+	}
+}
+
+type testEmail struct{ user, domain string }
+
+func (e testEmail) String() string { return e.user + "@" + e.domain }
+
+type testLevel int
+
+func (l testLevel) String() string { return "stringer" }
+
+func (l testLevel) MarshalText() ([]byte, error) {
+	if l < 0 {
+		return nil, fmt.Errorf("negative level")
+	}
+	return []byte(fmt.Sprintf("level-%d", int(l))), nil
+}
+
+func TestStringSchema_AcceptStringer(t *testing.T) {
+	email := testEmail{"ann", "example.com"}
+
+	// Without the flag, non-string types are rejected
+	if err := String().Email().Validate(email, nil); err == nil || err.Errors[0].Code != ErrCodeInvalidType {
+		t.Errorf("Expected invalid_type error, got: %v", err)
+	}
+
+	schema := String().Email().AcceptStringer()
+	if err := schema.Validate(email, nil); err != nil {
+		t.Errorf("Expected Stringer to validate, got: %v", err)
+	}
+	if err := schema.Validate(testEmail{"ann", "invalid"}, nil); err == nil || err.Errors[0].Code != ErrCodeInvalidString {
+		t.Errorf("Expected invalid_string error, got: %v", err)
+	}
+	if err := schema.Validate((*testEmail)(nil), nil); err == nil || err.Errors[0].Code != ErrCodeInvalidType {
+		t.Errorf("Expected invalid_type error for a nil pointer, got: %v", err)
+	}
+
+	// MarshalText takes precedence over String
+	parsed, errs := Parse[string](String().AcceptStringer(), testLevel(3))
+	if errs != nil || parsed != "level-3" {
+		t.Errorf("Expected \"level-3\", got %q (%v)", parsed, errs)
+	}
+	if err := String().AcceptStringer().Validate(testLevel(-1), nil); err == nil || err.Errors[0].Code != ErrCodeInvalidType {
+		t.Errorf("Expected invalid_type error for a MarshalText failure, got: %v", err)
+	}
+}
+
+func TestStringSchema_LengthCounting(t *testing.T) {
+	// Length is counted in runes by default
+	if err := String().Max(3).Validate("héé", nil); err != nil {
+		t.Errorf("Expected runes to be counted, got: %v", err)
+	}
+
+	family := "👨‍👩‍👧"
+	err := String().Max(1).Validate(family, nil)
+	if err == nil || err.Errors[0].Meta["actual"] != 5 {
+		t.Errorf("Expected 5 runes, got: %v", err)
+	}
+	if err := String().Max(1).CountGraphemes().Validate(family, nil); err != nil {
+		t.Errorf("Expected one grapheme, got: %v", err)
+	}
+
+	schema := String().Length(2).CountGraphemes()
+	if err := schema.Validate("🇯🇵"+family, nil); err != nil {
+		t.Errorf("Expected exactly 2 graphemes, got: %v", err)
+	}
+	err = schema.Validate("abc", nil)
+	if err == nil || err.Errors[0].Code != ErrCodeTooBig || err.Errors[0].Message != "String must be exactly 2 character(s) long, got 3" {
+		t.Errorf("Expected too_big exact length error, got: %v", err)
+	}
+	err = schema.Validate("a", nil)
+	if err == nil || err.Errors[0].Code != ErrCodeTooSmall {
+		t.Errorf("Expected too_small exact length error, got: %v", err)
+	}
+}
+
+func TestStringSchema_NonBlank(t *testing.T) {
+	schema := String().NonBlank()
+
+	if err := schema.Validate(" a ", nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+	for _, value := range []string{"", "   ", "\t\n"} {
+		err := schema.Validate(value, nil)
+		if err == nil || err.Errors[0].Code != ErrCodeTooSmall || err.Errors[0].Message != "String must not be blank" {
+			t.Errorf("Expected blank error for %q, got: %v", value, err)
+			continue
+		}
+		if err.Errors[0].Meta["constraint"] != "nonBlank" {
+			t.Errorf("Expected nonBlank constraint in meta, got: %v", err.Errors[0].Meta)
+		}
+	}
+
+	err := String().NonBlank("Please enter your name").Validate("  ", nil)
+	if err == nil || err.Errors[0].Message != "Please enter your name" {
+		t.Errorf("Expected custom message, got: %v", err)
+	}
+
+	// Parse returns the value untrimmed
+	parsed, errs := Parse[string](schema, " a ")
+	if errs != nil || parsed != " a " {
+		t.Errorf("Expected untrimmed value, got %q (%v)", parsed, errs)
+	}
+}
+
+func TestStringSchema_ByteLength(t *testing.T) {
+	// "héllo" is 5 runes but 6 bytes
+	schema := String().Max(5).MaxBytes(5)
+	err := schema.Validate("héllo", nil)
+	if err == nil || len(err.Errors) != 1 {
+		t.Fatalf("Expected only the byte limit to fail, got: %v", err)
+	}
+	if e := err.Errors[0]; e.Code != ErrCodeTooBig || e.Message != "String must be at most 5 byte(s) long, got 6" ||
+		e.Meta["constraint"] != "maxBytes" || e.Meta["actual"] != 6 {
+		t.Errorf("Unexpected error: %v %v", e, e.Meta)
+	}
+	if err := schema.Validate("hello", nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+
+	err = String().MinBytes(4).Validate("é", nil)
+	if err == nil || err.Errors[0].Code != ErrCodeTooSmall || err.Errors[0].Meta["constraint"] != "minBytes" {
+		t.Errorf("Expected minBytes error, got: %v", err)
+	}
+	if err := String().MinBytes(4).Validate("éé", nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+}
+
+func TestStringSchema_OneOfPattern(t *testing.T) {
+	schema := String().OneOfPattern(`^usr_[a-z0-9]{8}$`, `^\d+$`)
+
+	for _, value := range []string{"usr_ab12cd34", "12345"} {
+		if err := schema.Validate(value, nil); err != nil {
+			t.Errorf("Expected no errors for %q, got: %v", value, err)
+		}
+	}
+
+	err := schema.Validate("org_ab12cd34", nil)
+	if err == nil || err.Errors[0].Code != ErrCodeInvalidString {
+		t.Fatalf("Expected invalid_string error, got: %v", err)
+	}
+	if patterns, _ := err.Errors[0].Meta["patterns"].([]string); len(patterns) != 2 {
+		t.Errorf("Expected both patterns in meta, got %v", err.Errors[0].Meta)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for an invalid pattern")
+		}
+	}()
+	String().OneOfPattern(`[`)
+}
+
+func TestStringSchema_InCIDR(t *testing.T) {
+	schema := String().InCIDR("10.0.0.0/8", "2001:db8::/32")
+
+	for _, value := range []string{"10.1.2.3", "10.255.255.255", "2001:db8::1"} {
+		if err := schema.Validate(value, nil); err != nil {
+			t.Errorf("Expected no errors for %q, got: %v", value, err)
+		}
+	}
+
+	err := schema.Validate("192.168.1.1", nil)
+	if err == nil || err.Errors[0].Code != ErrCodeInvalidString || err.Errors[0].Meta["constraint"] != "inCIDR" {
+		t.Errorf("Expected inCIDR error, got: %v", err)
+	}
+	err = schema.Validate("not an ip", nil)
+	if err == nil || err.Errors[0].Message != "Invalid IP address" {
+		t.Errorf("Expected invalid IP error, got: %v", err)
+	}
+
+	if sample := GenerateSample(schema); schema.Validate(sample, nil) != nil {
+		t.Errorf("Expected sample %v to be valid", sample)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for an invalid network")
+		}
+	}()
+	String().InCIDR("10.0.0.0/33")
+}
+
+func TestStringSchema_Password(t *testing.T) {
+	schema := String().Password()
+
+	if err := schema.Validate("Secr3t!pass", nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+
+	// Each unmet requirement is reported separately
+	err := schema.Validate("abc", nil)
+	if err == nil {
+		t.Fatal("Expected errors for a weak password")
+	}
+	var requirements []string
+	for _, e := range err.Errors {
+		requirements = append(requirements, e.Meta["requirement"].(string))
+	}
+	if want := []string{"minLength", "uppercase", "digit", "symbol"}; !slices.Equal(requirements, want) {
+		t.Errorf("Expected requirements %v, got %v", want, requirements)
+	}
+	if err.Errors[0].Code != ErrCodeTooSmall || err.Errors[0].Meta["minimum"] != 8 || err.Errors[0].Meta["actual"] != 3 {
+		t.Errorf("Unexpected minLength error: %+v", err.Errors[0])
+	}
+
+	custom := String().Password(PasswordOptions{MinLength: 4, MaxLength: 6, RequireDigit: true})
+	if err := custom.Validate("abc1", nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+	err = custom.Validate("abcdefg", nil)
+	if err == nil || len(err.Errors) != 2 || err.Errors[0].Code != ErrCodeTooBig || err.Errors[1].Meta["requirement"] != "digit" {
+		t.Errorf("Expected maxLength and digit errors, got: %v", err)
+	}
+
+	for _, opts := range []PasswordOptions{
+		DefaultPasswordOptions,
+		{MinLength: 16, RequireSymbol: true},
+		{MaxLength: 6, RequireUpper: true, RequireDigit: true},
+		{RequireLower: true},
+	} {
+		schema := String().Password(opts)
+		if sample := GenerateSample(schema); schema.Validate(sample, nil) != nil {
+			t.Errorf("Expected sample %q to be valid for %+v", sample, opts)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for MaxLength below MinLength")
+		}
+	}()
+	String().Password(PasswordOptions{MinLength: 10, MaxLength: 5})
+}
@@ -1,24 +1,43 @@
 package gozod
 
 import (
+	"context"
 	"fmt"
+	"maps"
 	"reflect"
+	"slices"
 	"strings"
+	"sync"
 )
 
 // StructSchema validates struct values directly
 type StructSchema struct {
 	BaseSchema
-	shape  map[string]Schema // Maps struct field names (or JSON tag names) to schemas
-	strict bool              // If true, rejects unknown fields (default: false, allows extra fields)
+	shape     map[string]Schema // Maps struct field names (or JSON tag names) to schemas
+	keys      []string          // Shape keys in sorted order, so fields are validated deterministically
+	strict    bool              // If true, rejects unknown fields (default: false, allows extra fields)
+	mergeTags bool              // If true, gozod struct tags add constraints to the shape
+	optional  map[string]bool   // Fields that may be missing, nil or empty with omitempty, set by Partial
+	catchall  Schema            // If set, validates unknown fields instead of allowing or rejecting them
+	tagShapes *sync.Map         // Maps reflect.Type to its *taggedShape, built once per type by MergeTags
+}
+
+// taggedShape is the shape of a StructSchema with the gozod tags of one struct type merged in
+type taggedShape struct {
+	shape map[string]Schema
+	keys  []string
 }
 
 // Struct creates a new struct schema
 // The shape maps struct field names (or JSON tag names) to validation schemas
+// Fields are validated in sorted key order, as with Map
+// The shape is copied, so later changes to the caller's map do not affect the schema
 func Struct(shape Shape) *StructSchema {
+	shape = maps.Clone(shape)
 	return &StructSchema{
 		BaseSchema: BaseSchema{required: true},
 		shape:      map[string]Schema(shape),
+		keys:       slices.Sorted(maps.Keys(shape)),
 		strict:     false, // Extra fields allowed by default
 	}
 }
@@ -29,18 +48,139 @@ func (s *StructSchema) Nilable() *StructSchema {
 	return s
 }
 
+// Optional allows missing values without declaring null a valid value
+// Validation accepts nil as with Nilable; the difference shows in IsOptional and IsNilable
+func (s *StructSchema) Optional() *StructSchema {
+	s.required = false
+	return s
+}
+
 // Strict rejects unknown fields
 func (s *StructSchema) Strict() *StructSchema {
 	s.strict = true
 	return s
 }
 
+// Catchall validates the values of unknown fields against schema
+// It takes precedence over Strict
+func (s *StructSchema) Catchall(schema Schema) *StructSchema {
+	s.catchall = schema
+	return s
+}
+
+// Pick returns a new schema with only the given fields of the shape
+// It panics if a field is not in the shape
+func (s *StructSchema) Pick(keys ...string) *StructSchema {
+	c := s.Clone()
+	c.shape = pickShape("Pick", c.shape, keys)
+	c.keys = slices.Sorted(maps.Keys(c.shape))
+	return c
+}
+
+// Omit returns a new schema without the given fields of the shape
+// It panics if a field is not in the shape
+func (s *StructSchema) Omit(keys ...string) *StructSchema {
+	c := s.Clone()
+	c.shape = omitShape(c.shape, keys)
+	c.keys = slices.Sorted(maps.Keys(c.shape))
+	return c
+}
+
+// Extend returns a new schema with shape added to the existing shape
+// Fields already in the shape are replaced
+func (s *StructSchema) Extend(shape Shape) *StructSchema {
+	c := s.Clone()
+	if c.shape == nil {
+		c.shape = make(map[string]Schema, len(shape))
+	}
+	for key, schema := range shape {
+		c.shape[key] = schema
+		delete(c.optional, key)
+	}
+	c.keys = slices.Sorted(maps.Keys(c.shape))
+	return c
+}
+
+// Partial returns a new schema where the given fields (all fields if none are given) may be missing or nil
+// Zero values of omitempty fields count as missing
+// It panics if a field is not in the shape
+func (s *StructSchema) Partial(keys ...string) *StructSchema {
+	c := s.Clone()
+	c.optional = partialKeys(c.shape, c.optional, keys)
+	return c
+}
+
+// MergeTags merges constraints from `gozod:"..."` struct tags into the shape
+// Tags add to the shape rather than replace it: when both set the same constraint, the shape wins
+// Tagged fields missing from the shape get a schema derived from their Go type
+// Supported keys are min, max, nilable, email and url (strings) and positive and negative (numbers)
+// The merged shape is built once per struct type; passing a value of each type that will be validated
+// (e.g. MergeTags(User{})) builds it up front, so malformed tags panic here rather than in Validate
+// Finish configuring the shape schemas before the first validation
+func (s *StructSchema) MergeTags(samples ...any) *StructSchema {
+	s.mergeTags = true
+	if s.tagShapes == nil {
+		s.tagShapes = &sync.Map{}
+	}
+	for _, sample := range samples {
+		typ := reflect.TypeOf(sample)
+		if typ != nil && typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+		if typ == nil || typ.Kind() != reflect.Struct {
+			panic(fmt.Sprintf("gozod: MergeTags expects struct values, got %T", sample))
+		}
+		s.taggedShapeFor(typ)
+	}
+	return s
+}
+
+// DeepPartial returns a new schema where every field may be missing or nil, recursively
+// Nested object schemas, including the elements of arrays, are relaxed too; Lazy schemas are not
+func (s *StructSchema) DeepPartial() *StructSchema {
+	c := s.Clone()
+	deepPartial(c)
+	return c
+}
+
+// Fields returns a copy of the shape, keyed by field name
+// Modifying the returned map does not affect the schema
+func (s *StructSchema) Fields() Shape {
+	return Shape(maps.Clone(s.shape))
+}
+
+// Keys returns the field names of the shape in sorted order
+func (s *StructSchema) Keys() []string {
+	return slices.Clone(s.keys)
+}
+
+// AsMap returns a MapSchema with the same shape, options, refinements and custom errors
+// Validating StructToMap(v) with it reports the same errors as validating v with s, except that
+// refinements receive the map instead of the struct and MergeTags constraints are not included
+func (s *StructSchema) AsMap() *MapSchema {
+	c := s.Clone()
+	m := Map(c.shape)
+	m.BaseSchema = c.BaseSchema
+	m.strict = c.strict
+	m.optional = c.optional
+	m.catchall = c.catchall
+	return m
+}
+
 // Refine adds a custom validation function
 // The function receives the value and returns (isValid, errorMessage)
 // If isValid is false, validation fails with the provided errorMessage
 // If errorMessage is empty, a default message will be used
-func (s *StructSchema) Refine(validator RefineFunc) *StructSchema {
-	s.BaseSchema.addRefinement(validator)
+// Options such as OnlyIfValid and AbortOnFailure control when it runs
+func (s *StructSchema) Refine(validator RefineFunc, opts ...RefineOption) *StructSchema {
+	s.BaseSchema.addRefinement(validator, opts...)
+	return s
+}
+
+// UseRefinement attaches a refinement registered with RegisterRefinement
+// It panics if no refinement is registered under name
+func (s *StructSchema) UseRefinement(name string) *StructSchema {
+	s.BaseSchema.useRefinement(name)
 	return s
 }
 
@@ -52,16 +192,48 @@ func (s *StructSchema) SuperRefine(validator SuperRefineFunc) *StructSchema {
 	return s
 }
 
+// AsyncRefine adds a context-aware validation function
+// The function receives the context passed to ValidateCtx and is awaited before validation returns
+// Use it for expensive checks (e.g. database lookups) that need cancellation or deadlines
+func (s *StructSchema) AsyncRefine(validator AsyncRefineFunc) *StructSchema {
+	s.BaseSchema.addAsyncRefinement(validator)
+	return s
+}
+
+// RefineWithParent adds a validation function that also receives the enclosing object
+// It runs when the schema validates a field of a Map or Struct and the field itself is valid,
+// including a nil value on a Nilable field, which makes it suitable for conditionally required fields
+func (s *StructSchema) RefineWithParent(validator ParentRefineFunc) *StructSchema {
+	s.BaseSchema.addParentRefinement(validator)
+	return s
+}
+
+// Or returns a union that accepts values matching either s or other
+func (s *StructSchema) Or(other Schema) *UnionSchema {
+	return Union(s, other)
+}
+
+// And returns an intersection that requires values to match both s and other
+func (s *StructSchema) And(other Schema) *IntersectionSchema {
+	return Intersection(s, other)
+}
+
 // Validate validates a struct value against the schema
 func (s *StructSchema) Validate(value any, path []any) *ValidationErrors {
-	errors := &ValidationErrors{}
+	return s.ValidateCtx(context.Background(), value, path)
+}
+
+// ValidateCtx validates a value using ctx for cancellation and request scoping
+func (s *StructSchema) ValidateCtx(ctx context.Context, value any, path []any) *ValidationErrors {
+	// Errors are collected in a local value and only escape to the heap when validation fails
+	var errors ValidationErrors
 
 	// Handle nil/nilable
 	if value == nil {
-		if !s.nilable {
+		if !s.allowsNil() {
 			msg := s.getErrorMessage(path, ErrCodeRequired, "Required")
 			errors.Add(path, ErrCodeRequired, msg)
-			return errors
+			return errors.orNil()
 		}
 		return nil
 	}
@@ -70,10 +242,10 @@ func (s *StructSchema) Validate(value any, path []any) *ValidationErrors {
 	val := reflect.ValueOf(value)
 	if val.Kind() == reflect.Ptr {
 		if val.IsNil() {
-			if !s.nilable {
+			if !s.allowsNil() {
 				msg := s.getErrorMessage(path, ErrCodeRequired, "Required")
 				errors.Add(path, ErrCodeRequired, msg)
-				return errors
+				return errors.orNil()
 			}
 			return nil
 		}
@@ -84,36 +256,44 @@ func (s *StructSchema) Validate(value any, path []any) *ValidationErrors {
 	if val.Kind() != reflect.Struct {
 		msg := s.getErrorMessage(path, ErrCodeInvalidType, fmt.Sprintf("Expected struct, got %T", value))
 		errors.Add(path, ErrCodeInvalidType, msg)
-		return errors
+		return errors.orNil()
 	}
 
 	typ := val.Type()
 
-	// Build a map of field names to their reflect.Field for quick lookup
-	// This handles both struct field names and JSON tag names
-	fieldMap := make(map[string]reflect.StructField)
+	// Look up the field names for this type, computed once per type
+	fields := cachedStructFields(typ)
 
-	for i := 0; i < typ.NumField(); i++ {
-		field := typ.Field(i)
-		if !field.IsExported() {
-			continue
-		}
+	// Use the shape with tag constraints merged in, built once per type
+	shape, keys := s.shape, s.keys
+	if s.mergeTags {
+		tagged := s.taggedShapeFor(typ)
+		shape, keys = tagged.shape, tagged.keys
+	}
 
-		// Get the field name that will be used in the schema
-		fieldName := getStructFieldName(field)
-		fieldMap[fieldName] = field
+	// Record the top-level value for nested When schemas
+	ctx = withRoot(ctx, value)
+
+	// Stop at the nesting depth limit instead of descending into the contents
+	ctx, ok := s.enterNested(ctx, path, &errors)
+	if !ok {
+		return errors.orNil()
 	}
 
 	// Validate each field in the shape
-	for schemaFieldName, schema := range s.shape {
+	for _, schemaFieldName := range keys {
+		schema := shape[schemaFieldName]
 		fieldPath := PathAppend(path, schemaFieldName)
 
 		// Find the struct field by schema field name
-		structField, exists := fieldMap[schemaFieldName]
+		structField, exists := fields.byName[schemaFieldName]
+		if !exists && s.optional[schemaFieldName] {
+			continue
+		}
 		if !exists {
 			// Field not found in struct - this is a validation error
 			// (unless it's nilable, but we still need to validate it)
-			fieldErrors := schema.Validate(nil, fieldPath)
+			fieldErrors := schema.ValidateCtx(withAbsent(ctx), nil, fieldPath)
 			if fieldErrors != nil {
 				errors.Errors = append(errors.Errors, fieldErrors.Errors...)
 			}
@@ -121,11 +301,10 @@ func (s *StructSchema) Validate(value any, path []any) *ValidationErrors {
 		}
 
 		// Get the field value
-		structFieldName := structField.Name
-		fieldValue := val.FieldByName(structFieldName)
+		fieldValue := val.FieldByIndex(structField.Index)
 		if !fieldValue.IsValid() {
 			// Field exists but can't be accessed
-			fieldErrors := schema.Validate(nil, fieldPath)
+			fieldErrors := schema.ValidateCtx(ctx, nil, fieldPath)
 			if fieldErrors != nil {
 				errors.Errors = append(errors.Errors, fieldErrors.Errors...)
 			}
@@ -133,69 +312,102 @@ func (s *StructSchema) Validate(value any, path []any) *ValidationErrors {
 		}
 
 		// Get the interface value, handling pointers
-		var fieldInterface any
-		fieldType := structField.Type
-
-		if fieldType.Kind() == reflect.Ptr {
-			// Field is a pointer type
-			if fieldValue.IsNil() {
-				fieldInterface = nil
-			} else {
-				// Dereference the pointer
-				fieldInterface = fieldValue.Elem().Interface()
-			}
-		} else {
-			// Field is not a pointer
-			if fieldValue.CanInterface() {
-				fieldInterface = fieldValue.Interface()
-			} else {
-				// Field can't be interfaced, treat as nil
-				fieldInterface = nil
-			}
-		}
+		fieldInterface := structFieldInterface(fieldValue)
 
 		// Check for zero values with omitempty
+		// These would be omitted from JSON output, so they count as absent rather than null
+		fieldCtx := ctx
 		jsonTag := structField.Tag.Get("json")
 		hasOmitempty := strings.Contains(jsonTag, "omitempty")
 		if hasOmitempty && isEmptyValue(fieldInterface) {
 			// For omitempty fields, pass nil to validation
 			fieldInterface = nil
+			fieldCtx = withAbsent(ctx)
+		}
+		if fieldInterface == nil && s.optional[schemaFieldName] {
+			continue
 		}
 
 		// Validate the field
-		fieldErrors := schema.Validate(fieldInterface, fieldPath)
+		fieldErrors := schema.ValidateCtx(fieldCtx, fieldInterface, fieldPath)
+		if !fieldErrors.HasErrors() {
+			// Parent-aware refinements see the sibling fields (only if the field itself passed)
+			// The struct is only boxed when a refinement needs it
+			if refiner, ok := schema.(parentRefiner); ok && refiner.hasParentRefinements() {
+				if refineErrors := refiner.parentRefinementErrors(fieldInterface, val.Interface(), fieldPath); refineErrors != nil {
+					fieldErrors = MergeErrors(fieldErrors, refineErrors)
+				}
+			}
+		}
 		if fieldErrors != nil {
 			errors.Errors = append(errors.Errors, fieldErrors.Errors...)
 		}
 	}
 
-	// Check for unknown fields if strict mode is enabled
-	if s.strict {
-		for i := 0; i < typ.NumField(); i++ {
-			field := typ.Field(i)
-			if !field.IsExported() {
+	// Validate unknown fields against the catchall schema, or reject them in strict mode
+	// They are visited in sorted order and reported with the same wording as Map
+	if s.catchall != nil || s.strict {
+		for _, fieldName := range fields.unknown(shape) {
+			keyPath := PathAppend(path, fieldName)
+			if s.catchall != nil {
+				fieldValue := structFieldInterface(val.FieldByIndex(fields.byName[fieldName].Index))
+				fieldErrors := s.catchall.ValidateCtx(ctx, fieldValue, keyPath)
+				if fieldErrors != nil {
+					errors.Errors = append(errors.Errors, fieldErrors.Errors...)
+				}
 				continue
 			}
-
-			fieldName := getStructFieldName(field)
-			if _, exists := s.shape[fieldName]; !exists {
-				keyPath := PathAppend(path, fieldName)
-				msg := s.getErrorMessage(keyPath, ErrCodeUnrecognizedKeys, fmt.Sprintf("Unrecognized field '%s'", fieldName))
-				errors.Add(keyPath, ErrCodeUnrecognizedKeys, msg)
-			}
+			msg := s.getErrorMessage(keyPath, ErrCodeUnrecognizedKeys, fmt.Sprintf("Unrecognized key '%s'", fieldName))
+			errors.Add(keyPath, ErrCodeUnrecognizedKeys, msg)
 		}
 	}
 
 	// Apply custom refinements (only if type check passed)
-	s.applyRefinements(value, path, errors)
+	s.applyRefinements(value, path, &errors)
+
+	// Apply async refinements (only if type check passed)
+	s.applyAsyncRefinements(ctx, value, path, &errors)
 
 	// Apply super refinements (only if type check passed)
-	s.applySuperRefinements(value, path, errors)
+	s.applySuperRefinements(ctx, value, path, &errors)
 
-	if len(errors.Errors) == 0 {
-		return nil
+	return errors.orNil()
+}
+
+// Title sets a short human-readable title used in generated documentation
+func (s *StructSchema) Title(title string) *StructSchema {
+	s.annotations.Title = title
+	return s
+}
+
+// Describe sets a description used in generated documentation
+// It does not affect validation
+func (s *StructSchema) Describe(description string) *StructSchema {
+	s.annotations.Description = description
+	return s
+}
+
+// Example adds an example value used in generated documentation
+func (s *StructSchema) Example(example any) *StructSchema {
+	s.annotations.Examples = append(s.annotations.Examples, example)
+	return s
+}
+
+// Clone returns an independent copy of the schema
+// Constraints, refinements, custom errors and nested schemas are copied, so changing the clone does not affect s
+func (s *StructSchema) Clone() *StructSchema {
+	c := *s
+	c.BaseSchema = s.BaseSchema.clone()
+	c.shape = cloneShape(s.shape)
+	c.optional = maps.Clone(s.optional)
+	if s.catchall != nil {
+		c.catchall = CloneSchema(s.catchall)
+	}
+	// The clone's shape may change (e.g. with Extend), so its merged shapes are built afresh
+	if s.tagShapes != nil {
+		c.tagShapes = &sync.Map{}
 	}
-	return errors
+	return &c
 }
 
 // CustomError sets a custom error message for a specific error code
@@ -218,6 +430,119 @@ func (s *StructSchema) Type() string {
 	return "struct"
 }
 
+// structFields holds the exported fields of a struct type keyed by schema field name
+type structFields struct {
+	byName map[string]reflect.StructField
+	names  []string // Schema field names in declaration order
+}
+
+// structFieldCache maps reflect.Type to *structFields
+var structFieldCache sync.Map
+
+// cachedStructFields returns the exported fields of typ, computing them once per type
+func cachedStructFields(typ reflect.Type) *structFields {
+	if cached, ok := structFieldCache.Load(typ); ok {
+		return cached.(*structFields)
+	}
+
+	fields := &structFields{byName: make(map[string]reflect.StructField)}
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		// Get the field name that will be used in the schema
+		fieldName := getStructFieldName(field)
+		fields.byName[fieldName] = field
+		fields.names = append(fields.names, fieldName)
+	}
+
+	cached, _ := structFieldCache.LoadOrStore(typ, fields)
+	return cached.(*structFields)
+}
+
+// unknown returns the field names that are not in shape, in sorted order
+func (f *structFields) unknown(shape map[string]Schema) []string {
+	var unknown []string
+	for _, name := range f.names {
+		if _, exists := shape[name]; !exists {
+			unknown = append(unknown, name)
+		}
+	}
+	slices.Sort(unknown)
+	return unknown
+}
+
+// taggedShapeFor returns the shape with the gozod tags of typ merged in, building it on first use
+func (s *StructSchema) taggedShapeFor(typ reflect.Type) *taggedShape {
+	if cached, ok := s.tagShapes.Load(typ); ok {
+		return cached.(*taggedShape)
+	}
+	shape := s.shapeWithTags(typ)
+	cached, _ := s.tagShapes.LoadOrStore(typ, &taggedShape{shape: shape, keys: slices.Sorted(maps.Keys(shape))})
+	return cached.(*taggedShape)
+}
+
+// shapeWithTags returns the shape with gozod tag constraints of typ merged in
+func (s *StructSchema) shapeWithTags(typ reflect.Type) map[string]Schema {
+	shape := make(map[string]Schema, len(s.shape))
+	for name, schema := range s.shape {
+		shape[name] = schema
+	}
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		tag, ok := field.Tag.Lookup("gozod")
+		if !ok || !field.IsExported() {
+			continue
+		}
+		fieldName := getStructFieldName(field)
+		shape[fieldName] = schemaForTag(s.shape[fieldName], field, tag)
+	}
+	return shape
+}
+
+// StructToMap converts a struct, or a non-nil pointer to one, to the map[string]any that StructSchema sees
+// Keys are the schema field names (JSON tag names, or field names with a lowercase first letter);
+// zero values of omitempty fields are left out and pointer fields are dereferenced
+// It returns nil for any other value
+func StructToMap(value any) map[string]any {
+	val := reflect.ValueOf(value)
+	if val.Kind() == reflect.Ptr && !val.IsNil() {
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Struct {
+		return nil
+	}
+
+	fields := cachedStructFields(val.Type())
+	obj := make(map[string]any, len(fields.names))
+	for _, name := range fields.names {
+		field := fields.byName[name]
+		fieldValue := structFieldInterface(val.FieldByIndex(field.Index))
+		if strings.Contains(field.Tag.Get("json"), "omitempty") && isEmptyValue(fieldValue) {
+			continue
+		}
+		obj[name] = fieldValue
+	}
+	return obj
+}
+
+// structFieldInterface returns the value of a struct field, dereferencing pointers
+// Nil pointers and unexported fields are returned as nil
+func structFieldInterface(fieldValue reflect.Value) any {
+	if fieldValue.Kind() == reflect.Ptr {
+		if fieldValue.IsNil() {
+			return nil
+		}
+		fieldValue = fieldValue.Elem()
+	}
+	if !fieldValue.CanInterface() {
+		return nil
+	}
+	return fieldValue.Interface()
+}
+
 // getStructFieldName extracts the field name from a struct field
 // It prioritizes JSON tags, then falls back to the struct field name
 // Handles JSON tag options like "omitempty"
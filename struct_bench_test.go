@@ -0,0 +1,73 @@
+package gozod
+
+import (
+	"testing"
+)
+
+var personStructSchema = Struct(Shape{
+	"name":  String().Min(2).Max(50),
+	"email": String().Email(),
+	"age":   Int().Min(0).Max(150),
+})
+
+var userStructSchema = Struct(Shape{
+	"name":  String().Min(2).Max(50),
+	"email": String().Email(),
+	"age":   Int().Min(0).Max(150),
+	"address": Struct(Shape{
+		"street":   String().Min(5),
+		"city":     String().Min(2),
+		"zip_code": String().Min(5),
+	}),
+})
+
+func BenchmarkStructSchema_Validate_Simple(b *testing.B) {
+	person := BenchPerson{
+		Name:  "John Doe",
+		Email: "john@example.com",
+		Age:   30,
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = personStructSchema.Validate(person, nil)
+	}
+}
+
+func BenchmarkStructSchema_Validate_Nested(b *testing.B) {
+	user := BenchUser{
+		Name:  "John Doe",
+		Email: "john@example.com",
+		Age:   30,
+		Address: BenchAddress{
+			Street:  "123 Main St",
+			City:    "New York",
+			ZipCode: "10001",
+		},
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = userStructSchema.Validate(user, nil)
+	}
+}
+
+// plainStructSchema has no regex checks, so the benchmark measures the struct walk itself
+var plainStructSchema = Struct(Shape{
+	"name":  String().Min(2).Max(50),
+	"email": String().Min(3),
+	"age":   Int().Min(0).Max(150),
+})
+
+func BenchmarkStructSchema_Validate_Plain(b *testing.B) {
+	person := BenchPerson{
+		Name:  "John Doe",
+		Email: "john@example.com",
+		Age:   30,
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = plainStructSchema.Validate(person, nil)
+	}
+}
@@ -1,6 +1,8 @@
 package gozod
 
 import (
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -431,3 +433,159 @@ func TestStructSchema_ComplexExample(t *testing.T) {
 		t.Errorf("Expected no errors, got: %v", err)
 	}
 }
+
+func TestStructSchema_MergeTags(t *testing.T) {
+	type Signup struct {
+		Username string `json:"username" gozod:"min=5"`
+		Email    string `json:"email" gozod:"email"`
+		Age      int    `json:"age" gozod:"min=18"`
+	}
+
+	username := String().Max(20)
+	schema := Struct(Shape{
+		"username": username,
+		"email":    String(),
+	}).MergeTags()
+
+	if err := schema.Validate(Signup{Username: "gopher", Email: "gopher@example.com", Age: 30}, nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+
+	// The tag adds a min to a field also present in the shape, which keeps its max
+	err := schema.Validate(Signup{Username: "abc", Email: "gopher@example.com", Age: 30}, nil)
+	if err == nil || len(err.Errors) != 1 || err.Errors[0].Code != ErrCodeTooSmall {
+		t.Errorf("Expected %s error from tag min, got: %v", ErrCodeTooSmall, err)
+	}
+	err = schema.Validate(Signup{Username: strings.Repeat("a", 21), Email: "gopher@example.com", Age: 30}, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeTooBig {
+		t.Errorf("Expected %s error from shape max, got: %v", ErrCodeTooBig, err)
+	}
+
+	// Tag-only fields are validated too
+	err = schema.Validate(Signup{Username: "gopher", Email: "gopher@example.com", Age: 16}, nil)
+	if err == nil || !PathEqual(err.Errors[0].Path, []any{"age"}) {
+		t.Errorf("Expected error at age, got: %v", err)
+	}
+
+	// The shape schema itself is not modified
+	if err := username.Validate("abc", nil); err != nil {
+		t.Errorf("Expected shape schema to be unchanged, got: %v", err)
+	}
+}
+
+func TestStructSchema_MergeTags_Eager(t *testing.T) {
+	type Account struct {
+		Name string `json:"name" gozod:"min=3"`
+	}
+	type Broken struct {
+		Name string `json:"name" gozod:"min=three"`
+	}
+
+	schema := Struct(Shape{}).MergeTags(Account{}, &Account{})
+	tagged, ok := schema.tagShapes.Load(reflect.TypeOf(Account{}))
+	if !ok {
+		t.Fatal("Expected the merged shape to be built by MergeTags")
+	}
+
+	// Later validations reuse the merged shape instead of rebuilding it
+	for range 2 {
+		if err := schema.Validate(Account{Name: "ab"}, nil); err == nil || err.Errors[0].Code != ErrCodeTooSmall {
+			t.Errorf("Expected %s error from tag min, got: %v", ErrCodeTooSmall, err)
+		}
+	}
+	if again, _ := schema.tagShapes.Load(reflect.TypeOf(Account{})); again != tagged {
+		t.Error("Expected the cached merged shape to be reused")
+	}
+
+	// A malformed tag panics when the schema is built
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for a malformed tag")
+		}
+	}()
+	Struct(Shape{}).MergeTags(Broken{})
+}
+
+func TestStructSchema_MergeTags_ShapeWins(t *testing.T) {
+	type Item struct {
+		Name string `json:"name" gozod:"min=10"`
+	}
+
+	schema := Struct(Shape{"name": String().Min(2)}).MergeTags()
+	if err := schema.Validate(Item{Name: "abc"}, nil); err != nil {
+		t.Errorf("Expected shape min to win over tag min, got: %v", err)
+	}
+}
+
+func TestStructSchema_MatchesMap(t *testing.T) {
+	type Profile struct {
+		Name     string  `json:"name"`
+		Nickname string  `json:"nickname,omitempty"`
+		Age      *int    `json:"age"`
+		Email    string  `json:"email"`
+		Score    float64 `json:"score"`
+		Extra    string  `json:"extra"`
+	}
+
+	structSchema := Struct(Shape{
+		"name":     String().Min(3),
+		"nickname": String(),
+		"age":      Int().Min(18),
+		"email":    String().Email(),
+		"missing":  Bool(),
+		"score":    Float().Max(10),
+	}).Strict()
+	mapSchema := structSchema.AsMap()
+
+	age := 12
+	profile := Profile{Name: "Al", Age: &age, Email: "nope", Score: 11, Extra: "x"}
+
+	structErr := structSchema.Validate(profile, nil)
+	mapErr := mapSchema.Validate(StructToMap(&profile), nil)
+	if structErr == nil || mapErr == nil {
+		t.Fatalf("Expected errors from both, got %v and %v", structErr, mapErr)
+	}
+	if structErr.FormatErrors() != mapErr.FormatErrors() {
+		t.Errorf("Expected identical errors:\nstruct: %s\nmap:    %s", structErr.FormatErrors(), mapErr.FormatErrors())
+	}
+	for i := range structErr.Errors {
+		if structErr.Errors[i].Code != mapErr.Errors[i].Code {
+			t.Errorf("Expected identical codes at %d, got %s and %s", i, structErr.Errors[i].Code, mapErr.Errors[i].Code)
+		}
+	}
+
+	// Strict mode uses the same wording for both
+	last := structErr.Errors[len(structErr.Errors)-1]
+	if last.Code != ErrCodeUnrecognizedKeys || last.Message != "Unrecognized key 'extra'" {
+		t.Errorf("Expected unrecognized key error, got: %v", last)
+	}
+
+	if StructToMap("not a struct") != nil {
+		t.Error("Expected nil for a non-struct value")
+	}
+	if obj := StructToMap(profile); obj["age"] != 12 {
+		t.Errorf("Expected dereferenced pointer field, got %v", obj["age"])
+	}
+	if _, ok := StructToMap(profile)["nickname"]; ok {
+		t.Error("Expected empty omitempty field to be left out")
+	}
+}
+
+func TestStructSchema_ShapeIsCopied(t *testing.T) {
+	type User struct {
+		Name string `json:"name"`
+		Age  int    `json:"age"`
+	}
+
+	shape := Shape{"name": String().Min(2), "age": Int().Min(18)}
+	schema := Struct(shape)
+
+	// Changing the caller's map after construction must not affect the schema
+	delete(shape, "age")
+	shape["name"] = String()
+
+	err := schema.Validate(User{Name: "A", Age: 3}, nil)
+	if err == nil || len(err.Errors) != 2 {
+		t.Errorf("Expected errors for both original fields, got: %v", err)
+	}
+}
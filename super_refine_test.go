@@ -343,3 +343,47 @@ func TestSuperRefine_NestedPath(t *testing.T) {
 		t.Error("Expected to find error on nested path")
 	}
 }
+
+func TestSuperRefine_HasErrors(t *testing.T) {
+	called := false
+	schema := Map(map[string]Schema{
+		"password": String().Min(8),
+		"confirm":  String(),
+	}).SuperRefine(func(value any, ctx *SuperRefineContext) {
+		if ctx.HasErrorsAt([]any{"confirm"}) {
+			t.Error("Expected no errors at confirm")
+		}
+		// Skip the cross-field check because password already failed
+		if ctx.HasErrorsAt([]any{"password"}) {
+			if !ctx.HasAnyErrors() {
+				t.Error("Expected HasAnyErrors to report the field error")
+			}
+			return
+		}
+		called = true
+		m := value.(map[string]any)
+		if m["password"] != m["confirm"] {
+			ctx.AddIssue([]any{"confirm"}, ErrCodeCustomValidation, "Passwords do not match")
+		}
+	})
+
+	err := schema.Validate(map[string]any{"password": "short", "confirm": "other"}, nil)
+	if err == nil {
+		t.Fatal("Expected error for short password")
+	}
+	if called {
+		t.Error("Expected refinement to short-circuit")
+	}
+	if len(err.Errors) != 1 || err.Errors[0].Code != ErrCodeTooSmall {
+		t.Errorf("Expected only the password error, got: %v", err.Errors)
+	}
+
+	// With no prior errors, the check runs
+	err = schema.Validate(map[string]any{"password": "long enough", "confirm": "different"}, nil)
+	if !called {
+		t.Error("Expected refinement to run when fields are valid")
+	}
+	if err == nil || err.Errors[0].Code != ErrCodeCustomValidation {
+		t.Errorf("Expected %s error, got: %v", ErrCodeCustomValidation, err)
+	}
+}
@@ -0,0 +1,188 @@
+package gozod
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// tagConstraint is a single constraint parsed from a gozod struct tag, e.g. "min=3" or "email"
+type tagConstraint struct {
+	key   string
+	value string
+}
+
+// parseGozodTag parses a tag such as `gozod:"min=3,max=50,email"` into its constraints
+func parseGozodTag(tag string) []tagConstraint {
+	var constraints []tagConstraint
+	for _, part := range strings.Split(tag, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		key, value, _ := strings.Cut(part, "=")
+		constraints = append(constraints, tagConstraint{key: strings.TrimSpace(key), value: strings.TrimSpace(value)})
+	}
+	return constraints
+}
+
+// schemaForTag returns the schema used for a struct field carrying a gozod tag
+// If the field is in the shape, a copy of the shape schema is extended with the tag constraints;
+// constraints the shape already sets are left alone, so the shape wins on conflict
+// If the field is not in the shape, a schema is derived from the field's Go type
+// It panics on malformed tags or constraints that do not apply to the schema type
+func schemaForTag(schema Schema, field reflect.StructField, tag string) Schema {
+	if schema == nil {
+		schema = schemaForKind(field)
+	}
+
+	constraints := parseGozodTag(tag)
+	switch s := schema.(type) {
+	case *StringSchema:
+		merged := *s
+		for _, c := range constraints {
+			switch c.key {
+			case "min":
+				if merged.minLength == nil {
+					merged.Min(int(tagInt(field, c)))
+				}
+			case "max":
+				if merged.maxLength == nil {
+					merged.Max(int(tagInt(field, c)))
+				}
+			case "email":
+				merged.email = true
+			case "url":
+				merged.url = true
+			case "nilable":
+				merged.nilable = true
+			default:
+				panicTag(field, c, "string")
+			}
+		}
+		return &merged
+	case *IntSchema:
+		merged := *s
+		for _, c := range constraints {
+			switch c.key {
+			case "min":
+				if merged.min == nil {
+					merged.Min(tagInt(field, c))
+				}
+			case "max":
+				if merged.max == nil {
+					merged.Max(tagInt(field, c))
+				}
+			case "positive":
+				merged.positive = true
+			case "negative":
+				merged.negative = true
+			case "nilable":
+				merged.nilable = true
+			default:
+				panicTag(field, c, "int")
+			}
+		}
+		return &merged
+	case *FloatSchema:
+		merged := *s
+		for _, c := range constraints {
+			switch c.key {
+			case "min":
+				if merged.min == nil {
+					merged.Min(tagFloat(field, c))
+				}
+			case "max":
+				if merged.max == nil {
+					merged.Max(tagFloat(field, c))
+				}
+			case "positive":
+				merged.positive = true
+			case "negative":
+				merged.negative = true
+			case "nilable":
+				merged.nilable = true
+			default:
+				panicTag(field, c, "float")
+			}
+		}
+		return &merged
+	case *DurationSchema:
+		merged := *s
+		for _, c := range constraints {
+			switch c.key {
+			case "min":
+				if merged.min == nil {
+					merged.Min(tagDuration(field, c))
+				}
+			case "max":
+				if merged.max == nil {
+					merged.Max(tagDuration(field, c))
+				}
+			case "nilable":
+				merged.nilable = true
+			default:
+				panicTag(field, c, "duration")
+			}
+		}
+		return &merged
+	default:
+		panic(fmt.Sprintf("gozod: gozod tag on field %s is not supported for %s schemas", field.Name, schema.Type()))
+	}
+}
+
+// schemaForKind derives a base schema from a struct field's Go type for tag-only fields
+func schemaForKind(field reflect.StructField) Schema {
+	typ := field.Type
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ == reflect.TypeOf(time.Duration(0)) {
+		return Duration()
+	}
+	switch typ.Kind() {
+	case reflect.String:
+		return String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return Int()
+	case reflect.Float32, reflect.Float64:
+		return Float()
+	default:
+		panic(fmt.Sprintf("gozod: gozod tag on field %s is not supported for type %s", field.Name, field.Type))
+	}
+}
+
+// tagInt parses an integer tag value
+func tagInt(field reflect.StructField, c tagConstraint) int64 {
+	n, err := strconv.ParseInt(c.value, 10, 64)
+	if err != nil {
+		panic(fmt.Sprintf("gozod: invalid value %q for %q in gozod tag on field %s", c.value, c.key, field.Name))
+	}
+	return n
+}
+
+// tagFloat parses a float tag value
+func tagFloat(field reflect.StructField, c tagConstraint) float64 {
+	n, err := strconv.ParseFloat(c.value, 64)
+	if err != nil {
+		panic(fmt.Sprintf("gozod: invalid value %q for %q in gozod tag on field %s", c.value, c.key, field.Name))
+	}
+	return n
+}
+
+// tagDuration parses a duration tag value such as "1s" or "5m"
+func tagDuration(field reflect.StructField, c tagConstraint) time.Duration {
+	d, err := time.ParseDuration(c.value)
+	if err != nil {
+		panic(fmt.Sprintf("gozod: invalid value %q for %q in gozod tag on field %s", c.value, c.key, field.Name))
+	}
+	return d
+}
+
+// panicTag reports a constraint that does not apply to the schema type
+func panicTag(field reflect.StructField, c tagConstraint, schemaType string) {
+	panic(fmt.Sprintf("gozod: unknown %s constraint %q in gozod tag on field %s", schemaType, c.key, field.Name))
+}
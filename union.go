@@ -0,0 +1,198 @@
+package gozod
+
+import (
+	"context"
+	"slices"
+)
+
+// UnionSchema validates that a value matches at least one of several schemas
+type UnionSchema struct {
+	BaseSchema
+	options []Schema
+}
+
+// Union creates a schema that passes if the value matches any of the given schemas
+// Options are tried in order and validation stops at the first match
+// The options are copied, so later changes to the slice do not affect the schema
+func Union(options ...Schema) *UnionSchema {
+	return &UnionSchema{
+		BaseSchema: BaseSchema{required: true},
+		options:    slices.Clone(options),
+	}
+}
+
+// Nilable allows null values
+func (s *UnionSchema) Nilable() *UnionSchema {
+	s.nilable = true
+	return s
+}
+
+// Optional allows missing values without declaring null a valid value
+// Validation accepts nil as with Nilable; the difference shows in IsOptional and IsNilable
+func (s *UnionSchema) Optional() *UnionSchema {
+	s.required = false
+	return s
+}
+
+// Refine adds a custom validation function
+// The function receives the value and returns (isValid, errorMessage)
+// If isValid is false, validation fails with the provided errorMessage
+// If errorMessage is empty, a default message will be used
+// Options such as OnlyIfValid and AbortOnFailure control when it runs
+func (s *UnionSchema) Refine(validator RefineFunc, opts ...RefineOption) *UnionSchema {
+	s.BaseSchema.addRefinement(validator, opts...)
+	return s
+}
+
+// UseRefinement attaches a refinement registered with RegisterRefinement
+// It panics if no refinement is registered under name
+func (s *UnionSchema) UseRefinement(name string) *UnionSchema {
+	s.BaseSchema.useRefinement(name)
+	return s
+}
+
+// SuperRefine adds a super refinement validation function
+// Similar to Refine, but provides a context object for adding errors with custom paths and codes
+// This allows for more fine-grained control over error reporting, similar to Zod's superRefine
+func (s *UnionSchema) SuperRefine(validator SuperRefineFunc) *UnionSchema {
+	s.BaseSchema.addSuperRefinement(validator)
+	return s
+}
+
+// AsyncRefine adds a context-aware validation function
+// The function receives the context passed to ValidateCtx and is awaited before validation returns
+// Use it for expensive checks (e.g. database lookups) that need cancellation or deadlines
+func (s *UnionSchema) AsyncRefine(validator AsyncRefineFunc) *UnionSchema {
+	s.BaseSchema.addAsyncRefinement(validator)
+	return s
+}
+
+// RefineWithParent adds a validation function that also receives the enclosing object
+// It runs when the schema validates a field of a Map or Struct and the field itself is valid,
+// including a nil value on a Nilable field, which makes it suitable for conditionally required fields
+func (s *UnionSchema) RefineWithParent(validator ParentRefineFunc) *UnionSchema {
+	s.BaseSchema.addParentRefinement(validator)
+	return s
+}
+
+// Or adds other as another option of the union, so chained Or calls build a single flat union
+func (s *UnionSchema) Or(other Schema) *UnionSchema {
+	s.options = append(s.options, other)
+	return s
+}
+
+// And returns an intersection that requires values to match both s and other
+func (s *UnionSchema) And(other Schema) *IntersectionSchema {
+	return Intersection(s, other)
+}
+
+// Validate validates a value against the union schema
+func (s *UnionSchema) Validate(value any, path []any) *ValidationErrors {
+	return s.ValidateCtx(context.Background(), value, path)
+}
+
+// ValidateCtx validates a value using ctx for cancellation and request scoping
+func (s *UnionSchema) ValidateCtx(ctx context.Context, value any, path []any) *ValidationErrors {
+	// Errors are collected in a local value and only escape to the heap when validation fails
+	var errors ValidationErrors
+
+	// Handle nil/nilable
+	if value == nil && s.allowsNil() {
+		return nil
+	}
+
+	// Try each option, keeping every option's errors for the report
+	// An option that only reports warnings matches, and its warnings are kept
+	var optionErrors [][]ValidationError
+	matched := false
+	for _, option := range s.options {
+		optionErr := option.ValidateCtx(ctx, value, path)
+		if !optionErr.HasErrors() {
+			matched = true
+			if optionErr != nil {
+				errors.Errors = append(errors.Errors, optionErr.Errors...)
+			}
+			break
+		}
+		optionErrors = append(optionErrors, optionErr.Errors)
+	}
+
+	if !matched {
+		if value == nil {
+			msg := s.getErrorMessage(path, ErrCodeRequired, "Required")
+			errors.Add(path, ErrCodeRequired, msg)
+			return errors.orNil()
+		}
+		meta := map[string]any{"unionErrors": optionErrors}
+		msg := s.getErrorMessageWithMeta(path, ErrCodeInvalidUnion, "Value does not match any of the allowed types", meta)
+		errors.AddWithMeta(path, ErrCodeInvalidUnion, msg, meta)
+		return errors.orNil()
+	}
+
+	// Nil values that matched an option (e.g. Null()) skip refinements
+	if value == nil {
+		return errors.orNil()
+	}
+
+	// Apply custom refinements (only if an option matched)
+	s.applyRefinements(value, path, &errors)
+
+	// Apply async refinements (only if an option matched)
+	s.applyAsyncRefinements(ctx, value, path, &errors)
+
+	// Apply super refinements (only if an option matched)
+	s.applySuperRefinements(ctx, value, path, &errors)
+
+	return errors.orNil()
+}
+
+// Title sets a short human-readable title used in generated documentation
+func (s *UnionSchema) Title(title string) *UnionSchema {
+	s.annotations.Title = title
+	return s
+}
+
+// Describe sets a description used in generated documentation
+// It does not affect validation
+func (s *UnionSchema) Describe(description string) *UnionSchema {
+	s.annotations.Description = description
+	return s
+}
+
+// Example adds an example value used in generated documentation
+func (s *UnionSchema) Example(example any) *UnionSchema {
+	s.annotations.Examples = append(s.annotations.Examples, example)
+	return s
+}
+
+// Clone returns an independent copy of the schema
+// Constraints, refinements, custom errors and nested schemas are copied, so changing the clone does not affect s
+func (s *UnionSchema) Clone() *UnionSchema {
+	c := *s
+	c.BaseSchema = s.BaseSchema.clone()
+	c.options = make([]Schema, len(s.options))
+	for i, option := range s.options {
+		c.options[i] = CloneSchema(option)
+	}
+	return &c
+}
+
+// CustomError sets a custom error message for a specific error code
+func (s *UnionSchema) CustomError(code, message string) *UnionSchema {
+	if s.BaseSchema.customErrors == nil {
+		s.BaseSchema.customErrors = make(map[string]string)
+	}
+	s.BaseSchema.customErrors[code] = message
+	return s
+}
+
+// SetErrorFormatter sets a custom error formatter function
+func (s *UnionSchema) SetErrorFormatter(formatter CustomErrorFunc) *UnionSchema {
+	s.BaseSchema.errorFormatter = formatter
+	return s
+}
+
+// Type returns the schema type
+func (s *UnionSchema) Type() string {
+	return "union"
+}
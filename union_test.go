@@ -0,0 +1,71 @@
+package gozod
+
+import (
+	"testing"
+)
+
+func TestUnionSchema_Validate(t *testing.T) {
+	schema := Union(String().Min(3), Int())
+
+	// Matches first option
+	if err := schema.Validate("hello", nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+
+	// Matches second option
+	if err := schema.Validate(42, nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+
+	// Matches no option
+	err := schema.Validate("hi", nil)
+	if err == nil {
+		t.Fatal("Expected error for value matching no option")
+	}
+	if len(err.Errors) != 1 || err.Errors[0].Code != ErrCodeInvalidUnion {
+		t.Fatalf("Expected a single %s error, got: %v", ErrCodeInvalidUnion, err.Errors)
+	}
+	unionErrors := err.Errors[0].Meta["unionErrors"].([][]ValidationError)
+	if len(unionErrors) != 2 {
+		t.Fatalf("Expected errors for 2 options, got %d", len(unionErrors))
+	}
+	if unionErrors[0][0].Code != ErrCodeTooSmall || unionErrors[1][0].Code != ErrCodeInvalidType {
+		t.Errorf("Unexpected option errors: %v", unionErrors)
+	}
+}
+
+func TestUnionSchema_Nil(t *testing.T) {
+	// Nil is required unless an option or the union accepts it
+	err := Union(String(), Int()).Validate(nil, nil)
+	if err == nil || err.Errors[0].Code != ErrCodeRequired {
+		t.Errorf("Expected %s error, got: %v", ErrCodeRequired, err)
+	}
+
+	if err := Union(String(), Int()).Nilable().Validate(nil, nil); err != nil {
+		t.Errorf("Expected no errors for nilable union, got: %v", err)
+	}
+
+	if err := Union(String(), Null()).Validate(nil, nil); err != nil {
+		t.Errorf("Expected no errors for union with Null(), got: %v", err)
+	}
+}
+
+func TestUnionSchema_Refine(t *testing.T) {
+	schema := Union(String(), Int()).Refine(func(value any) (bool, string) {
+		return value != "forbidden", "Forbidden value"
+	})
+
+	if err := schema.Validate("allowed", nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+	err := schema.Validate("forbidden", nil)
+	if err == nil || err.Errors[0].Code != ErrCodeCustomValidation {
+		t.Errorf("Expected %s error, got: %v", ErrCodeCustomValidation, err)
+	}
+}
+
+func TestUnionSchema_Type(t *testing.T) {
+	if Union(String()).Type() != "union" {
+		t.Errorf("Expected type 'union', got '%s'", Union(String()).Type())
+	}
+}
@@ -231,6 +231,34 @@ func TestValidate_NilableSchema(t *testing.T) {
 	}
 }
 
+func TestValidate_OptionalSchema(t *testing.T) {
+	schema := Map(map[string]Schema{
+		"nickname": String().Min(2).Optional(),
+		"tags":     Array(String()).Optional(),
+		"deleted":  Null().Optional(),
+	})
+
+	if err := schema.Validate(map[string]any{}, nil); err != nil {
+		t.Errorf("Expected no errors for missing optional fields, got: %v", err)
+	}
+	if err := schema.Validate(map[string]any{"nickname": "A"}, nil); err == nil {
+		t.Error("Expected error for invalid present value")
+	}
+
+	// Optional and Nilable are recorded separately
+	optional := String().Optional()
+	if !optional.IsOptional() || optional.IsNilable() {
+		t.Error("Expected Optional() to set IsOptional only")
+	}
+	nilable := String().Nilable()
+	if nilable.IsOptional() || !nilable.IsNilable() {
+		t.Error("Expected Nilable() to set IsNilable only")
+	}
+	if String().IsOptional() {
+		t.Error("Expected schemas to be required by default")
+	}
+}
+
 func TestValidate_ChainedValidators(t *testing.T) {
 	schema := String().Min(3).Max(20).Email()
 
@@ -572,3 +600,80 @@ func TestValidate_Refine(t *testing.T) {
 		t.Error("Expected to find refine error for duplicate values")
 	}
 }
+
+func TestSchema_Annotations(t *testing.T) {
+	schema := String().Email().
+		Title("Email").
+		Describe("Primary contact address").
+		Example("ann@example.com").
+		Example("bob@example.com")
+
+	annotations := schema.Annotations()
+	if annotations.Title != "Email" {
+		t.Errorf("Expected title 'Email', got '%s'", annotations.Title)
+	}
+	if annotations.Description != "Primary contact address" {
+		t.Errorf("Expected description, got '%s'", annotations.Description)
+	}
+	if len(annotations.Examples) != 2 || annotations.Examples[0] != "ann@example.com" {
+		t.Errorf("Unexpected examples: %v", annotations.Examples)
+	}
+
+	// Annotations do not affect validation
+	if err := schema.Validate("ann@example.com", nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+	if err := schema.Validate("not-an-email", nil); err == nil {
+		t.Error("Expected error for invalid email")
+	}
+
+	// Annotations are reachable through the Schema interface for doc generators
+	shape := Shape{"age": Int().Describe("Age in years")}
+	annotated, ok := shape["age"].(interface{ Annotations() Annotations })
+	if !ok || annotated.Annotations().Description != "Age in years" {
+		t.Error("Expected annotations to be reachable from a shape")
+	}
+}
+
+func TestValidate_RefineOptions(t *testing.T) {
+	calls := 0
+	matches := func(value any) (bool, string) {
+		calls++
+		m := value.(map[string]any)
+		return m["password"] == m["confirm"], "Passwords must match"
+	}
+
+	// OnlyIfValid skips the refinement when the fields already failed
+	schema := Map(map[string]Schema{
+		"password": String().Min(8),
+		"confirm":  String(),
+	}).Refine(matches, OnlyIfValid)
+
+	err := schema.Validate(map[string]any{"password": "short", "confirm": "other"}, nil)
+	if err == nil || len(err.Errors) != 1 || err.Errors[0].Code != ErrCodeTooSmall || calls != 0 {
+		t.Errorf("Expected only the min error without running the refinement, got: %v (%d calls)", err, calls)
+	}
+	err = schema.Validate(map[string]any{"password": "long enough", "confirm": "other"}, nil)
+	if err == nil || err.Errors[0].Message != "Passwords must match" || calls != 1 {
+		t.Errorf("Expected the refinement error, got: %v (%d calls)", err, calls)
+	}
+
+	// AbortOnFailure skips the remaining refinements, including super refinements
+	later := false
+	aborting := String().
+		Refine(func(value any) (bool, string) { return false, "First" }, AbortOnFailure).
+		Refine(func(value any) (bool, string) { later = true; return false, "Second" }).
+		SuperRefine(func(value any, ctx *SuperRefineContext) { later = true })
+	err = aborting.Validate("x", nil)
+	if err == nil || len(err.Errors) != 1 || err.Errors[0].Message != "First" || later {
+		t.Errorf("Expected validation to stop after the first refinement, got: %v", err)
+	}
+
+	// A passing AbortOnFailure refinement lets the others run
+	passing := String().
+		Refine(func(value any) (bool, string) { return true, "" }, AbortOnFailure, OnlyIfValid).
+		Refine(func(value any) (bool, string) { return false, "Second" })
+	if err := passing.Validate("x", nil); err == nil || err.Errors[0].Message != "Second" {
+		t.Errorf("Expected the second refinement to run, got: %v", err)
+	}
+}
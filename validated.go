@@ -0,0 +1,34 @@
+package gozod
+
+import (
+	"context"
+)
+
+// ValidatedSchema marks a field as holding an already-validated sub-document
+// It accepts any value, including nil, without inspecting it
+//
+// Use it when the same data has been validated by another layer and re-validating
+// it would be wasted work. The schema trusts the caller completely: nothing about
+// the value is checked, so only use it for data that cannot come straight from
+// untrusted input.
+type ValidatedSchema struct{}
+
+// Validated creates a passthrough schema for pre-validated values
+func Validated() *ValidatedSchema {
+	return &ValidatedSchema{}
+}
+
+// Validate accepts any value
+func (s *ValidatedSchema) Validate(value any, path []any) *ValidationErrors {
+	return nil
+}
+
+// ValidateCtx accepts any value
+func (s *ValidatedSchema) ValidateCtx(ctx context.Context, value any, path []any) *ValidationErrors {
+	return nil
+}
+
+// Type returns the schema type
+func (s *ValidatedSchema) Type() string {
+	return "validated"
+}
@@ -0,0 +1,37 @@
+package gozod
+
+import (
+	"testing"
+)
+
+func TestValidatedSchema_AcceptsAnything(t *testing.T) {
+	schema := Map(map[string]Schema{
+		"kind":    String().OneOf("order", "invoice"),
+		"payload": Validated(),
+	})
+
+	payloads := []any{
+		map[string]any{"id": 1, "lines": []any{}},
+		[]int{1, 2, 3},
+		"text",
+		42,
+		nil,
+	}
+	for _, payload := range payloads {
+		err := schema.Validate(map[string]any{"kind": "order", "payload": payload}, nil)
+		if err != nil {
+			t.Errorf("Expected no errors for payload %v, got: %v", payload, err)
+		}
+	}
+
+	// Missing key is accepted too
+	if err := schema.Validate(map[string]any{"kind": "order"}, nil); err != nil {
+		t.Errorf("Expected no errors for missing payload, got: %v", err)
+	}
+}
+
+func TestValidatedSchema_Type(t *testing.T) {
+	if Validated().Type() != "validated" {
+		t.Errorf("Expected type 'validated', got '%s'", Validated().Type())
+	}
+}
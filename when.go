@@ -0,0 +1,92 @@
+package gozod
+
+import (
+	"context"
+)
+
+// rootKey is the context key under which the top-level value being validated is stored
+type rootKey struct{}
+
+// withRoot returns ctx carrying value as the root, unless ctx already has one
+// Map, Struct and Array call it with their non-nil input so that nested When schemas can see the top-level value
+func withRoot(ctx context.Context, value any) context.Context {
+	if ctx.Value(rootKey{}) != nil {
+		return ctx
+	}
+	return context.WithValue(ctx, rootKey{}, value)
+}
+
+// rootFromContext returns the root stored in ctx, or fallback if there is none
+func rootFromContext(ctx context.Context, fallback any) any {
+	if root := ctx.Value(rootKey{}); root != nil {
+		return root
+	}
+	return fallback
+}
+
+// WhenSchema validates a value with one of two schemas, chosen by a predicate over the root value
+// The root is the top-level value passed to Validate; a When used at the top level sees its own value
+type WhenSchema struct {
+	predicate  func(root any) bool
+	thenSchema Schema
+	elseSchema Schema
+}
+
+// When creates a schema that validates with thenSchema if predicate(root) is true, and with elseSchema otherwise
+// A nil elseSchema accepts any value when the predicate is false
+func When(predicate func(root any) bool, thenSchema, elseSchema Schema) *WhenSchema {
+	if predicate == nil {
+		panic("gozod: When requires a non-nil predicate")
+	}
+	if thenSchema == nil {
+		panic("gozod: When requires a non-nil then schema")
+	}
+	return &WhenSchema{predicate: predicate, thenSchema: thenSchema, elseSchema: elseSchema}
+}
+
+// selected returns the schema chosen for the root in ctx, or nil if no validation applies
+func (s *WhenSchema) selected(ctx context.Context, value any) Schema {
+	if s.predicate(rootFromContext(ctx, value)) {
+		return s.thenSchema
+	}
+	return s.elseSchema
+}
+
+// Validate validates a value against the selected schema
+func (s *WhenSchema) Validate(value any, path []any) *ValidationErrors {
+	return s.ValidateCtx(context.Background(), value, path)
+}
+
+// ValidateCtx validates a value against the selected schema using ctx
+func (s *WhenSchema) ValidateCtx(ctx context.Context, value any, path []any) *ValidationErrors {
+	schema := s.selected(ctx, value)
+	if schema == nil {
+		return nil
+	}
+	return schema.ValidateCtx(ctx, value, path)
+}
+
+// parse validates value against the selected schema and returns its output
+func (s *WhenSchema) parse(ctx context.Context, value any, path []any) (any, *ValidationErrors) {
+	schema := s.selected(ctx, value)
+	if schema == nil {
+		return value, nil
+	}
+	return parseValue(ctx, schema, value, path)
+}
+
+// Clone returns an independent copy of the schema
+// Both branches are deep-copied; the predicate is shared
+func (s *WhenSchema) Clone() *WhenSchema {
+	c := *s
+	c.thenSchema = CloneSchema(s.thenSchema)
+	if s.elseSchema != nil {
+		c.elseSchema = CloneSchema(s.elseSchema)
+	}
+	return &c
+}
+
+// Type returns the schema type
+func (s *WhenSchema) Type() string {
+	return "when"
+}
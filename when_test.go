@@ -0,0 +1,85 @@
+package gozod
+
+import (
+	"testing"
+)
+
+func TestWhen_RootPredicate(t *testing.T) {
+	isUS := func(root any) bool {
+		return root.(map[string]any)["country"] == "US"
+	}
+	schema := Map(map[string]Schema{
+		"country": String(),
+		"zipCode": When(isUS, String().Regex(`^\d{5}$`), String().Nilable()),
+	})
+
+	tests := []struct {
+		name  string
+		value map[string]any
+		valid bool
+	}{
+		{"US with valid zip", map[string]any{"country": "US", "zipCode": "94103"}, true},
+		{"US with invalid zip", map[string]any{"country": "US", "zipCode": "SW1A"}, false},
+		{"US without zip", map[string]any{"country": "US"}, false},
+		{"UK with any zip", map[string]any{"country": "UK", "zipCode": "SW1A"}, true},
+		{"UK without zip", map[string]any{"country": "UK"}, true},
+	}
+	for _, tt := range tests {
+		err := schema.Validate(tt.value, nil)
+		if tt.valid && err != nil {
+			t.Errorf("%s: expected no errors, got: %v", tt.name, err)
+		}
+		if !tt.valid && err == nil {
+			t.Errorf("%s: expected errors", tt.name)
+		}
+	}
+}
+
+func TestWhen_NestedSeesTopLevel(t *testing.T) {
+	// The predicate receives the top-level value, not the nested object
+	schema := Map(map[string]Schema{
+		"strict": Bool(),
+		"items": Array(Map(map[string]Schema{
+			"name": When(func(root any) bool {
+				return root.(map[string]any)["strict"] == true
+			}, String().Min(3), nil),
+		})),
+	})
+
+	value := map[string]any{
+		"strict": true,
+		"items":  []any{map[string]any{"name": "ab"}},
+	}
+	err := schema.Validate(value, nil)
+	if err == nil || !PathEqual(err.Errors[0].Path, []any{"items", 0, "name"}) {
+		t.Errorf("Expected error at items.0.name, got: %v", err)
+	}
+
+	value["strict"] = false
+	if err := schema.Validate(value, nil); err != nil {
+		t.Errorf("Expected no errors with a nil else schema, got: %v", err)
+	}
+}
+
+func TestWhen_TopLevel(t *testing.T) {
+	schema := When(func(root any) bool {
+		_, isString := root.(string)
+		return isString
+	}, String().Email(), Int().Positive())
+
+	if err := schema.Validate("user@example.com", nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+	if err := schema.Validate(5, nil); err != nil {
+		t.Errorf("Expected no errors, got: %v", err)
+	}
+	if err := schema.Validate(-5, nil); err == nil {
+		t.Error("Expected error for -5")
+	}
+
+	// Parse forwards to the selected schema
+	n, errs := Parse[int](When(func(any) bool { return true }, Int(), nil), 42.0)
+	if errs != nil || n != 42 {
+		t.Errorf("Expected 42, got %v (%v)", n, errs)
+	}
+}